@@ -147,11 +147,15 @@ func (m *StopTrackingResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StopTrackingResponse proto.InternalMessageInfo
 
+// Streams to watchers also carry status changes, which have a status set
+// and no location.
 type TrackingData struct {
 	TrackeeName          string   `protobuf:"bytes,1,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
 	Longitude            float64  `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
 	Latitude             float64  `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
 	Timestamp            int64    `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Status               string   `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	PausedUntil          int64    `protobuf:"varint,6,opt,name=pausedUntil,proto3" json:"pausedUntil,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -210,6 +214,20 @@ func (m *TrackingData) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *TrackingData) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TrackingData) GetPausedUntil() int64 {
+	if m != nil {
+		return m.PausedUntil
+	}
+	return 0
+}
+
 type ReportLocationResponse struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -241,9 +259,160 @@ func (m *ReportLocationResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ReportLocationResponse proto.InternalMessageInfo
 
+// until is a unix time, 0 pauses until sharing is resumed.
+type PauseSharingRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Until                int64    `protobuf:"varint,2,opt,name=until,proto3" json:"until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseSharingRequest) Reset()         { *m = PauseSharingRequest{} }
+func (m *PauseSharingRequest) String() string { return proto.CompactTextString(m) }
+func (*PauseSharingRequest) ProtoMessage()    {}
+func (*PauseSharingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{5}
+}
+
+func (m *PauseSharingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseSharingRequest.Unmarshal(m, b)
+}
+func (m *PauseSharingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseSharingRequest.Marshal(b, m, deterministic)
+}
+func (m *PauseSharingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSharingRequest.Merge(m, src)
+}
+func (m *PauseSharingRequest) XXX_Size() int {
+	return xxx_messageInfo_PauseSharingRequest.Size(m)
+}
+func (m *PauseSharingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSharingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseSharingRequest proto.InternalMessageInfo
+
+func (m *PauseSharingRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *PauseSharingRequest) GetUntil() int64 {
+	if m != nil {
+		return m.Until
+	}
+	return 0
+}
+
+type PauseSharingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PauseSharingResponse) Reset()         { *m = PauseSharingResponse{} }
+func (m *PauseSharingResponse) String() string { return proto.CompactTextString(m) }
+func (*PauseSharingResponse) ProtoMessage()    {}
+func (*PauseSharingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{6}
+}
+
+func (m *PauseSharingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PauseSharingResponse.Unmarshal(m, b)
+}
+func (m *PauseSharingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PauseSharingResponse.Marshal(b, m, deterministic)
+}
+func (m *PauseSharingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseSharingResponse.Merge(m, src)
+}
+func (m *PauseSharingResponse) XXX_Size() int {
+	return xxx_messageInfo_PauseSharingResponse.Size(m)
+}
+func (m *PauseSharingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseSharingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseSharingResponse proto.InternalMessageInfo
+
+type ResumeSharingRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeSharingRequest) Reset()         { *m = ResumeSharingRequest{} }
+func (m *ResumeSharingRequest) String() string { return proto.CompactTextString(m) }
+func (*ResumeSharingRequest) ProtoMessage()    {}
+func (*ResumeSharingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{7}
+}
+
+func (m *ResumeSharingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeSharingRequest.Unmarshal(m, b)
+}
+func (m *ResumeSharingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeSharingRequest.Marshal(b, m, deterministic)
+}
+func (m *ResumeSharingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeSharingRequest.Merge(m, src)
+}
+func (m *ResumeSharingRequest) XXX_Size() int {
+	return xxx_messageInfo_ResumeSharingRequest.Size(m)
+}
+func (m *ResumeSharingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeSharingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeSharingRequest proto.InternalMessageInfo
+
+func (m *ResumeSharingRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type ResumeSharingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResumeSharingResponse) Reset()         { *m = ResumeSharingResponse{} }
+func (m *ResumeSharingResponse) String() string { return proto.CompactTextString(m) }
+func (*ResumeSharingResponse) ProtoMessage()    {}
+func (*ResumeSharingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{8}
+}
+
+func (m *ResumeSharingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResumeSharingResponse.Unmarshal(m, b)
+}
+func (m *ResumeSharingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResumeSharingResponse.Marshal(b, m, deterministic)
+}
+func (m *ResumeSharingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResumeSharingResponse.Merge(m, src)
+}
+func (m *ResumeSharingResponse) XXX_Size() int {
+	return xxx_messageInfo_ResumeSharingResponse.Size(m)
+}
+func (m *ResumeSharingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResumeSharingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResumeSharingResponse proto.InternalMessageInfo
+
 type RegisterRequest struct {
 	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
 	Trackable            bool     `protobuf:"varint,2,opt,name=trackable,proto3" json:"trackable,omitempty"`
+	Password             string   `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Organization         string   `protobuf:"bytes,4,opt,name=organization,proto3" json:"organization,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -253,7 +422,7 @@ func (m *RegisterRequest) Reset()         { *m = RegisterRequest{} }
 func (m *RegisterRequest) String() string { return proto.CompactTextString(m) }
 func (*RegisterRequest) ProtoMessage()    {}
 func (*RegisterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{5}
+	return fileDescriptor_1c19e669b665ab3c, []int{9}
 }
 
 func (m *RegisterRequest) XXX_Unmarshal(b []byte) error {
@@ -288,6 +457,20 @@ func (m *RegisterRequest) GetTrackable() bool {
 	return false
 }
 
+func (m *RegisterRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+func (m *RegisterRequest) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	UserId               int64    `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -299,7 +482,7 @@ func (m *RegisterResponse) Reset()         { *m = RegisterResponse{} }
 func (m *RegisterResponse) String() string { return proto.CompactTextString(m) }
 func (*RegisterResponse) ProtoMessage()    {}
 func (*RegisterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{6}
+	return fileDescriptor_1c19e669b665ab3c, []int{10}
 }
 
 func (m *RegisterResponse) XXX_Unmarshal(b []byte) error {
@@ -338,7 +521,7 @@ func (m *StartSessionRequest) Reset()         { *m = StartSessionRequest{} }
 func (m *StartSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*StartSessionRequest) ProtoMessage()    {}
 func (*StartSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{7}
+	return fileDescriptor_1c19e669b665ab3c, []int{11}
 }
 
 func (m *StartSessionRequest) XXX_Unmarshal(b []byte) error {
@@ -367,6 +550,7 @@ func (m *StartSessionRequest) GetUserName() string {
 }
 
 type StartSessionResponse struct {
+	SessionId            string   `protobuf:"bytes,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -376,7 +560,7 @@ func (m *StartSessionResponse) Reset()         { *m = StartSessionResponse{} }
 func (m *StartSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*StartSessionResponse) ProtoMessage()    {}
 func (*StartSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{8}
+	return fileDescriptor_1c19e669b665ab3c, []int{12}
 }
 
 func (m *StartSessionResponse) XXX_Unmarshal(b []byte) error {
@@ -397,6 +581,13 @@ func (m *StartSessionResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StartSessionResponse proto.InternalMessageInfo
 
+func (m *StartSessionResponse) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
 type StopSessionRequest struct {
 	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -408,7 +599,7 @@ func (m *StopSessionRequest) Reset()         { *m = StopSessionRequest{} }
 func (m *StopSessionRequest) String() string { return proto.CompactTextString(m) }
 func (*StopSessionRequest) ProtoMessage()    {}
 func (*StopSessionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{9}
+	return fileDescriptor_1c19e669b665ab3c, []int{13}
 }
 
 func (m *StopSessionRequest) XXX_Unmarshal(b []byte) error {
@@ -446,7 +637,7 @@ func (m *StopSessionResponse) Reset()         { *m = StopSessionResponse{} }
 func (m *StopSessionResponse) String() string { return proto.CompactTextString(m) }
 func (*StopSessionResponse) ProtoMessage()    {}
 func (*StopSessionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{10}
+	return fileDescriptor_1c19e669b665ab3c, []int{14}
 }
 
 func (m *StopSessionResponse) XXX_Unmarshal(b []byte) error {
@@ -477,7 +668,7 @@ func (m *GetTrackablesRequest) Reset()         { *m = GetTrackablesRequest{} }
 func (m *GetTrackablesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTrackablesRequest) ProtoMessage()    {}
 func (*GetTrackablesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{11}
+	return fileDescriptor_1c19e669b665ab3c, []int{15}
 }
 
 func (m *GetTrackablesRequest) XXX_Unmarshal(b []byte) error {
@@ -509,7 +700,7 @@ func (m *GetTrackablesResponse) Reset()         { *m = GetTrackablesResponse{} }
 func (m *GetTrackablesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetTrackablesResponse) ProtoMessage()    {}
 func (*GetTrackablesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{12}
+	return fileDescriptor_1c19e669b665ab3c, []int{16}
 }
 
 func (m *GetTrackablesResponse) XXX_Unmarshal(b []byte) error {
@@ -548,7 +739,7 @@ func (m *SessionIdsRequest) Reset()         { *m = SessionIdsRequest{} }
 func (m *SessionIdsRequest) String() string { return proto.CompactTextString(m) }
 func (*SessionIdsRequest) ProtoMessage()    {}
 func (*SessionIdsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{13}
+	return fileDescriptor_1c19e669b665ab3c, []int{17}
 }
 
 func (m *SessionIdsRequest) XXX_Unmarshal(b []byte) error {
@@ -577,8 +768,10 @@ func (m *SessionIdsRequest) GetUserName() string {
 }
 
 type SessionId struct {
-	SessionId            int64    `protobuf:"varint,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	// Deprecated: sequential ids are no longer exposed, use id.
+	SessionId            int64    `protobuf:"varint,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"` // Deprecated: Do not use.
 	Timestamp            int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Id                   string   `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -588,7 +781,7 @@ func (m *SessionId) Reset()         { *m = SessionId{} }
 func (m *SessionId) String() string { return proto.CompactTextString(m) }
 func (*SessionId) ProtoMessage()    {}
 func (*SessionId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{14}
+	return fileDescriptor_1c19e669b665ab3c, []int{18}
 }
 
 func (m *SessionId) XXX_Unmarshal(b []byte) error {
@@ -609,6 +802,7 @@ func (m *SessionId) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SessionId proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *SessionId) GetSessionId() int64 {
 	if m != nil {
 		return m.SessionId
@@ -623,6 +817,13 @@ func (m *SessionId) GetTimestamp() int64 {
 	return 0
 }
 
+func (m *SessionId) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type SessionIdsResponse struct {
 	SessionId            []*SessionId `protobuf:"bytes,1,rep,name=sessionId,proto3" json:"sessionId,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -634,7 +835,7 @@ func (m *SessionIdsResponse) Reset()         { *m = SessionIdsResponse{} }
 func (m *SessionIdsResponse) String() string { return proto.CompactTextString(m) }
 func (*SessionIdsResponse) ProtoMessage()    {}
 func (*SessionIdsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{15}
+	return fileDescriptor_1c19e669b665ab3c, []int{19}
 }
 
 func (m *SessionIdsResponse) XXX_Unmarshal(b []byte) error {
@@ -663,7 +864,9 @@ func (m *SessionIdsResponse) GetSessionId() []*SessionId {
 }
 
 type SessionDataRequest struct {
-	SessionId            int64    `protobuf:"varint,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	// Deprecated: sequential ids are no longer accepted, use id.
+	SessionId            int64    `protobuf:"varint,1,opt,name=sessionId,proto3" json:"sessionId,omitempty"` // Deprecated: Do not use.
+	Id                   string   `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -673,7 +876,7 @@ func (m *SessionDataRequest) Reset()         { *m = SessionDataRequest{} }
 func (m *SessionDataRequest) String() string { return proto.CompactTextString(m) }
 func (*SessionDataRequest) ProtoMessage()    {}
 func (*SessionDataRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{16}
+	return fileDescriptor_1c19e669b665ab3c, []int{20}
 }
 
 func (m *SessionDataRequest) XXX_Unmarshal(b []byte) error {
@@ -694,6 +897,7 @@ func (m *SessionDataRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_SessionDataRequest proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *SessionDataRequest) GetSessionId() int64 {
 	if m != nil {
 		return m.SessionId
@@ -701,6 +905,13 @@ func (m *SessionDataRequest) GetSessionId() int64 {
 	return 0
 }
 
+func (m *SessionDataRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
 type SessionDataResponse struct {
 	TrackingData         []*TrackingData `protobuf:"bytes,1,rep,name=trackingData,proto3" json:"trackingData,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
@@ -712,7 +923,7 @@ func (m *SessionDataResponse) Reset()         { *m = SessionDataResponse{} }
 func (m *SessionDataResponse) String() string { return proto.CompactTextString(m) }
 func (*SessionDataResponse) ProtoMessage()    {}
 func (*SessionDataResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1c19e669b665ab3c, []int{17}
+	return fileDescriptor_1c19e669b665ab3c, []int{21}
 }
 
 func (m *SessionDataResponse) XXX_Unmarshal(b []byte) error {
@@ -740,464 +951,7236 @@ func (m *SessionDataResponse) GetTrackingData() []*TrackingData {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*StartTrackingRequest)(nil), "pb.potpie.locationtracker.StartTrackingRequest")
-	proto.RegisterType((*StopTrackingRequest)(nil), "pb.potpie.locationtracker.StopTrackingRequest")
-	proto.RegisterType((*StopTrackingResponse)(nil), "pb.potpie.locationtracker.StopTrackingResponse")
-	proto.RegisterType((*TrackingData)(nil), "pb.potpie.locationtracker.TrackingData")
-	proto.RegisterType((*ReportLocationResponse)(nil), "pb.potpie.locationtracker.ReportLocationResponse")
-	proto.RegisterType((*RegisterRequest)(nil), "pb.potpie.locationtracker.RegisterRequest")
-	proto.RegisterType((*RegisterResponse)(nil), "pb.potpie.locationtracker.RegisterResponse")
-	proto.RegisterType((*StartSessionRequest)(nil), "pb.potpie.locationtracker.StartSessionRequest")
-	proto.RegisterType((*StartSessionResponse)(nil), "pb.potpie.locationtracker.StartSessionResponse")
-	proto.RegisterType((*StopSessionRequest)(nil), "pb.potpie.locationtracker.StopSessionRequest")
-	proto.RegisterType((*StopSessionResponse)(nil), "pb.potpie.locationtracker.StopSessionResponse")
-	proto.RegisterType((*GetTrackablesRequest)(nil), "pb.potpie.locationtracker.GetTrackablesRequest")
-	proto.RegisterType((*GetTrackablesResponse)(nil), "pb.potpie.locationtracker.GetTrackablesResponse")
-	proto.RegisterType((*SessionIdsRequest)(nil), "pb.potpie.locationtracker.SessionIdsRequest")
-	proto.RegisterType((*SessionId)(nil), "pb.potpie.locationtracker.SessionId")
-	proto.RegisterType((*SessionIdsResponse)(nil), "pb.potpie.locationtracker.SessionIdsResponse")
-	proto.RegisterType((*SessionDataRequest)(nil), "pb.potpie.locationtracker.SessionDataRequest")
-	proto.RegisterType((*SessionDataResponse)(nil), "pb.potpie.locationtracker.SessionDataResponse")
+type LoginRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Organization         string   `protobuf:"bytes,3,opt,name=organization,proto3" json:"organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("locationtracker.proto", fileDescriptor_1c19e669b665ab3c) }
+func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
+func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
+func (*LoginRequest) ProtoMessage()    {}
+func (*LoginRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{22}
+}
 
-var fileDescriptor_1c19e669b665ab3c = []byte{
-	// 582 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xda, 0x30,
-	0x14, 0x26, 0xcd, 0x54, 0xc1, 0x83, 0xb6, 0xab, 0x29, 0x88, 0x45, 0x3b, 0x20, 0x6b, 0xd2, 0x50,
-	0xb7, 0x06, 0x4a, 0x8f, 0xbb, 0x55, 0x93, 0xaa, 0xaa, 0xd3, 0x0e, 0x81, 0xc3, 0xb4, 0x9b, 0x29,
-	0x16, 0x8a, 0x06, 0x49, 0x1a, 0x9b, 0xff, 0xd0, 0x9f, 0x3d, 0x39, 0x76, 0x1c, 0x3b, 0xed, 0x82,
-	0x27, 0xf5, 0xe8, 0x97, 0xf7, 0xbe, 0xef, 0x7b, 0xf6, 0xf7, 0x1e, 0xc0, 0x60, 0x9b, 0x3e, 0x12,
-	0x1e, 0xa7, 0x09, 0xcf, 0xc9, 0xe3, 0x1f, 0x9a, 0x87, 0x59, 0x9e, 0xf2, 0x14, 0x7d, 0xc8, 0x56,
-	0x61, 0x96, 0xf2, 0x2c, 0xa6, 0x61, 0x2d, 0x01, 0x2f, 0xe1, 0x62, 0xc1, 0x49, 0xce, 0x97, 0xe2,
-	0x1c, 0x27, 0x9b, 0x88, 0x3e, 0xed, 0x29, 0xe3, 0x68, 0x0c, 0x5d, 0x99, 0x42, 0x7f, 0x92, 0x1d,
-	0x1d, 0x79, 0x63, 0x6f, 0xd2, 0x89, 0xcc, 0x10, 0x0a, 0xa0, 0xbd, 0x67, 0x34, 0x2f, 0x3e, 0x1f,
-	0x15, 0x9f, 0xf5, 0x19, 0x2f, 0xa0, 0xbf, 0xe0, 0x69, 0xf6, 0xb6, 0xa0, 0x43, 0x21, 0xd5, 0x04,
-	0x65, 0x59, 0x9a, 0x30, 0x8a, 0x9f, 0x3d, 0xe8, 0x95, 0xc1, 0xef, 0x84, 0x13, 0x07, 0x9a, 0x8f,
-	0xd0, 0xd9, 0xa6, 0xc9, 0x26, 0xe6, 0xfb, 0xb5, 0xe4, 0xf1, 0xa2, 0x2a, 0x20, 0x44, 0x6c, 0x09,
-	0x97, 0x1f, 0xfd, 0xe2, 0xa3, 0x3e, 0x8b, 0x4a, 0x1e, 0xef, 0x28, 0xe3, 0x64, 0x97, 0x8d, 0xde,
-	0x8d, 0xbd, 0x89, 0x1f, 0x55, 0x01, 0x3c, 0x82, 0x61, 0x44, 0xb3, 0x34, 0xe7, 0x3f, 0xd4, 0x35,
-	0x6b, 0x91, 0x0f, 0x70, 0x16, 0xd1, 0x4d, 0xcc, 0x38, 0xcd, 0xcb, 0xdb, 0x30, 0x7b, 0xf5, 0xec,
-	0x5e, 0x0b, 0x1a, 0xa1, 0x97, 0xac, 0xb6, 0x52, 0x60, 0x3b, 0xaa, 0x02, 0xf8, 0x12, 0xde, 0x57,
-	0x60, 0x92, 0x00, 0x0d, 0xe1, 0x58, 0x54, 0xdf, 0xaf, 0x0b, 0x2c, 0x3f, 0x52, 0x27, 0x7c, 0x2d,
-	0x9e, 0x82, 0xe4, 0x7c, 0x41, 0x19, 0x2b, 0x04, 0x1d, 0x24, 0x97, 0x17, 0x6d, 0x96, 0xa8, 0x1e,
-	0x66, 0x80, 0xc4, 0x03, 0xfc, 0x07, 0xd2, 0x40, 0xfa, 0xa0, 0x0e, 0x34, 0x84, 0x8b, 0x3b, 0x2a,
-	0x2d, 0x27, 0xfa, 0x61, 0x0a, 0x0a, 0xdf, 0xc0, 0xa0, 0x16, 0x57, 0xcd, 0xd9, 0x1c, 0xbe, 0xc5,
-	0x31, 0x85, 0x73, 0x85, 0x7f, 0xbf, 0x66, 0x2e, 0xa2, 0xee, 0xa0, 0xa3, 0x0b, 0xc4, 0x45, 0xb3,
-	0xf2, 0xa0, 0x6e, 0xae, 0x0a, 0xd8, 0xaf, 0x7d, 0x54, 0x7f, 0xed, 0x5f, 0x80, 0x4c, 0x66, 0xa5,
-	0xf5, 0xd6, 0x46, 0xf4, 0x27, 0xdd, 0xf9, 0xa7, 0xf0, 0x9f, 0x03, 0x18, 0x6a, 0x04, 0x83, 0x17,
-	0xcf, 0x35, 0xb2, 0x30, 0x74, 0xd9, 0x54, 0xa3, 0x56, 0xbc, 0x82, 0xbe, 0x55, 0xa3, 0xe4, 0x3c,
-	0x40, 0x8f, 0x1b, 0xc3, 0xa1, 0x14, 0x7d, 0x6e, 0x50, 0x64, 0xce, 0x52, 0x64, 0x15, 0xcf, 0x9f,
-	0xdb, 0x70, 0x56, 0x5a, 0x7b, 0x29, 0xd3, 0x11, 0x85, 0x76, 0x69, 0x46, 0x74, 0xd9, 0x00, 0x5b,
-	0xb3, 0x7f, 0xf0, 0xc5, 0x29, 0x57, 0x39, 0xa6, 0x85, 0x38, 0x9c, 0x58, 0xde, 0x40, 0xd3, 0x86,
-	0xfa, 0xd7, 0xdc, 0x15, 0xcc, 0xdc, 0x0b, 0x34, 0xeb, 0x13, 0xf4, 0xcc, 0x51, 0x40, 0x61, 0xd3,
-	0x4b, 0xbe, 0x1c, 0xb3, 0x60, 0xea, 0x9c, 0xaf, 0x29, 0x13, 0xe8, 0x1a, 0x33, 0x83, 0xae, 0x1a,
-	0x11, 0xea, 0xd3, 0x18, 0x84, 0xae, 0xe9, 0x9a, 0x6f, 0x07, 0x27, 0xd6, 0x2f, 0x00, 0x3a, 0xa8,
-	0xb9, 0xb6, 0xd6, 0x03, 0x57, 0x33, 0xe1, 0xd6, 0xcc, 0x93, 0x37, 0x5a, 0x6d, 0x71, 0x74, 0x48,
-	0x70, 0x9d, 0x6c, 0xea, 0x9c, 0xaf, 0x3b, 0xcc, 0xe0, 0xd4, 0xde, 0xca, 0xc8, 0x55, 0x71, 0x70,
-	0xdd, 0x68, 0xd2, 0x57, 0x37, 0x7d, 0x6b, 0xe2, 0xa1, 0xa4, 0x30, 0x6b, 0xb5, 0x1c, 0xd0, 0x57,
-	0x97, 0x0d, 0xa0, 0x9d, 0x7a, 0xe5, 0x98, 0x6d, 0xd8, 0xf4, 0xb4, 0xe2, 0x2b, 0x7e, 0x03, 0x1d,
-	0x20, 0x8c, 0xd5, 0xd2, 0x6c, 0x9b, 0x97, 0x5b, 0x05, 0xb7, 0x6e, 0xfb, 0xbf, 0xcf, 0xc3, 0x6f,
-	0xb5, 0xd4, 0xd5, 0x71, 0xf1, 0x7f, 0xe3, 0xe6, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x80, 0xf5,
-	0xfa, 0x8f, 0x88, 0x08, 0x00, 0x00,
+func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
+}
+func (m *LoginRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginRequest.Marshal(b, m, deterministic)
+}
+func (m *LoginRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginRequest.Merge(m, src)
+}
+func (m *LoginRequest) XXX_Size() int {
+	return xxx_messageInfo_LoginRequest.Size(m)
+}
+func (m *LoginRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_LoginRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *LoginRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
 
-// LocationTrackerClient is the client API for LocationTracker service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type LocationTrackerClient interface {
-	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
-	GetTrackables(ctx context.Context, in *GetTrackablesRequest, opts ...grpc.CallOption) (*GetTrackablesResponse, error)
-	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
-	StopSession(ctx context.Context, in *StopSessionRequest, opts ...grpc.CallOption) (*StopSessionResponse, error)
-	StartTracking(ctx context.Context, in *StartTrackingRequest, opts ...grpc.CallOption) (LocationTracker_StartTrackingClient, error)
-	StopTracking(ctx context.Context, in *StopTrackingRequest, opts ...grpc.CallOption) (*StopTrackingResponse, error)
-	ReportLocation(ctx context.Context, opts ...grpc.CallOption) (LocationTracker_ReportLocationClient, error)
-	GetSessionIds(ctx context.Context, in *SessionIdsRequest, opts ...grpc.CallOption) (*SessionIdsResponse, error)
-	GetSessionData(ctx context.Context, in *SessionDataRequest, opts ...grpc.CallOption) (*SessionDataResponse, error)
+func (m *LoginRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
 }
 
-type locationTrackerClient struct {
-	cc *grpc.ClientConn
+func (m *LoginRequest) GetOrganization() string {
+	if m != nil {
+		return m.Organization
+	}
+	return ""
 }
 
-func NewLocationTrackerClient(cc *grpc.ClientConn) LocationTrackerClient {
-	return &locationTrackerClient{cc}
+type LoginResponse struct {
+	AccessToken          string   `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *locationTrackerClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
-	out := new(RegisterResponse)
-	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/Register", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
+func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
+func (*LoginResponse) ProtoMessage()    {}
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{23}
 }
 
-func (c *locationTrackerClient) GetTrackables(ctx context.Context, in *GetTrackablesRequest, opts ...grpc.CallOption) (*GetTrackablesResponse, error) {
-	out := new(GetTrackablesResponse)
-	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetTrackables", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
+}
+func (m *LoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginResponse.Marshal(b, m, deterministic)
+}
+func (m *LoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginResponse.Merge(m, src)
+}
+func (m *LoginResponse) XXX_Size() int {
+	return xxx_messageInfo_LoginResponse.Size(m)
+}
+func (m *LoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginResponse.DiscardUnknown(m)
 }
 
-func (c *locationTrackerClient) StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error) {
-	out := new(StartSessionResponse)
-	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/StartSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_LoginResponse proto.InternalMessageInfo
+
+func (m *LoginResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
 	}
-	return out, nil
+	return ""
 }
 
-func (c *locationTrackerClient) StopSession(ctx context.Context, in *StopSessionRequest, opts ...grpc.CallOption) (*StopSessionResponse, error) {
-	out := new(StopSessionResponse)
-	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/StopSession", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *LoginResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
 	}
-	return out, nil
+	return ""
 }
 
-func (c *locationTrackerClient) StartTracking(ctx context.Context, in *StartTrackingRequest, opts ...grpc.CallOption) (LocationTracker_StartTrackingClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_LocationTracker_serviceDesc.Streams[0], "/pb.potpie.locationtracker.LocationTracker/StartTracking", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &locationTrackerStartTrackingClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *LoginResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
 	}
-	return x, nil
+	return 0
 }
 
-type LocationTracker_StartTrackingClient interface {
-	Recv() (*TrackingData, error)
-	grpc.ClientStream
+type RefreshTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshTokenRequest) Reset()         { *m = RefreshTokenRequest{} }
+func (m *RefreshTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenRequest) ProtoMessage()    {}
+func (*RefreshTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{24}
+}
+
+func (m *RefreshTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenRequest.Unmarshal(m, b)
+}
+func (m *RefreshTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RefreshTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenRequest.Merge(m, src)
+}
+func (m *RefreshTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenRequest.Size(m)
+}
+func (m *RefreshTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenRequest proto.InternalMessageInfo
+
+func (m *RefreshTokenRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type RefreshTokenResponse struct {
+	AccessToken          string   `protobuf:"bytes,1,opt,name=accessToken,proto3" json:"accessToken,omitempty"`
+	RefreshToken         string   `protobuf:"bytes,2,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,3,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefreshTokenResponse) Reset()         { *m = RefreshTokenResponse{} }
+func (m *RefreshTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RefreshTokenResponse) ProtoMessage()    {}
+func (*RefreshTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{25}
+}
+
+func (m *RefreshTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefreshTokenResponse.Unmarshal(m, b)
+}
+func (m *RefreshTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefreshTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *RefreshTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefreshTokenResponse.Merge(m, src)
+}
+func (m *RefreshTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RefreshTokenResponse.Size(m)
+}
+func (m *RefreshTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefreshTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefreshTokenResponse proto.InternalMessageInfo
+
+func (m *RefreshTokenResponse) GetAccessToken() string {
+	if m != nil {
+		return m.AccessToken
+	}
+	return ""
+}
+
+func (m *RefreshTokenResponse) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+func (m *RefreshTokenResponse) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type RevokeTokenRequest struct {
+	RefreshToken         string   `protobuf:"bytes,1,opt,name=refreshToken,proto3" json:"refreshToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenRequest) Reset()         { *m = RevokeTokenRequest{} }
+func (m *RevokeTokenRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenRequest) ProtoMessage()    {}
+func (*RevokeTokenRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{26}
+}
+
+func (m *RevokeTokenRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokenRequest.Unmarshal(m, b)
+}
+func (m *RevokeTokenRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokenRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeTokenRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenRequest.Merge(m, src)
+}
+func (m *RevokeTokenRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokenRequest.Size(m)
+}
+func (m *RevokeTokenRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenRequest proto.InternalMessageInfo
+
+func (m *RevokeTokenRequest) GetRefreshToken() string {
+	if m != nil {
+		return m.RefreshToken
+	}
+	return ""
+}
+
+type RevokeTokenResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTokenResponse) Reset()         { *m = RevokeTokenResponse{} }
+func (m *RevokeTokenResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeTokenResponse) ProtoMessage()    {}
+func (*RevokeTokenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{27}
+}
+
+func (m *RevokeTokenResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTokenResponse.Unmarshal(m, b)
+}
+func (m *RevokeTokenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTokenResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeTokenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTokenResponse.Merge(m, src)
+}
+func (m *RevokeTokenResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeTokenResponse.Size(m)
+}
+func (m *RevokeTokenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTokenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTokenResponse proto.InternalMessageInfo
+
+type ChangePasswordRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	OldPassword          string   `protobuf:"bytes,2,opt,name=oldPassword,proto3" json:"oldPassword,omitempty"`
+	NewPassword          string   `protobuf:"bytes,3,opt,name=newPassword,proto3" json:"newPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{28}
+}
+
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
+}
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+}
+func (m *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(m, src)
+}
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
+}
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+
+func (m *ChangePasswordRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetOldPassword() string {
+	if m != nil {
+		return m.OldPassword
+	}
+	return ""
+}
+
+func (m *ChangePasswordRequest) GetNewPassword() string {
+	if m != nil {
+		return m.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{} }
+func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordResponse) ProtoMessage()    {}
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{29}
+}
+
+func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
+}
+func (m *ChangePasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordResponse.Marshal(b, m, deterministic)
+}
+func (m *ChangePasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordResponse.Merge(m, src)
+}
+func (m *ChangePasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordResponse.Size(m)
+}
+func (m *ChangePasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChangePasswordResponse proto.InternalMessageInfo
+
+type ApiKey struct {
+	KeyId                string   `protobuf:"bytes,1,opt,name=keyId,proto3" json:"keyId,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	LastUsedAt           int64    `protobuf:"varint,4,opt,name=lastUsedAt,proto3" json:"lastUsedAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApiKey) Reset()         { *m = ApiKey{} }
+func (m *ApiKey) String() string { return proto.CompactTextString(m) }
+func (*ApiKey) ProtoMessage()    {}
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{30}
+}
+
+func (m *ApiKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKey.Unmarshal(m, b)
+}
+func (m *ApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKey.Marshal(b, m, deterministic)
+}
+func (m *ApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKey.Merge(m, src)
+}
+func (m *ApiKey) XXX_Size() int {
+	return xxx_messageInfo_ApiKey.Size(m)
+}
+func (m *ApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKey proto.InternalMessageInfo
+
+func (m *ApiKey) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *ApiKey) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ApiKey) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ApiKey) GetLastUsedAt() int64 {
+	if m != nil {
+		return m.LastUsedAt
+	}
+	return 0
+}
+
+type CreateApiKeyRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Label                string   `protobuf:"bytes,2,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateApiKeyRequest) Reset()         { *m = CreateApiKeyRequest{} }
+func (m *CreateApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyRequest) ProtoMessage()    {}
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{31}
+}
+
+func (m *CreateApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyRequest.Unmarshal(m, b)
+}
+func (m *CreateApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyRequest.Merge(m, src)
+}
+func (m *CreateApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyRequest.Size(m)
+}
+func (m *CreateApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiKeyRequest proto.InternalMessageInfo
+
+func (m *CreateApiKeyRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *CreateApiKeyRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type CreateApiKeyResponse struct {
+	ApiKey               *ApiKey  `protobuf:"bytes,1,opt,name=apiKey,proto3" json:"apiKey,omitempty"`
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateApiKeyResponse) Reset()         { *m = CreateApiKeyResponse{} }
+func (m *CreateApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyResponse) ProtoMessage()    {}
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{32}
+}
+
+func (m *CreateApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyResponse.Unmarshal(m, b)
+}
+func (m *CreateApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyResponse.Merge(m, src)
+}
+func (m *CreateApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyResponse.Size(m)
+}
+func (m *CreateApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiKeyResponse proto.InternalMessageInfo
+
+func (m *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *CreateApiKeyResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ListApiKeysRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApiKeysRequest) Reset()         { *m = ListApiKeysRequest{} }
+func (m *ListApiKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*ListApiKeysRequest) ProtoMessage()    {}
+func (*ListApiKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{33}
+}
+
+func (m *ListApiKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiKeysRequest.Unmarshal(m, b)
+}
+func (m *ListApiKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiKeysRequest.Marshal(b, m, deterministic)
+}
+func (m *ListApiKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiKeysRequest.Merge(m, src)
+}
+func (m *ListApiKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApiKeysRequest.Size(m)
+}
+func (m *ListApiKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiKeysRequest proto.InternalMessageInfo
+
+func (m *ListApiKeysRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type ListApiKeysResponse struct {
+	ApiKey               []*ApiKey `protobuf:"bytes,1,rep,name=apiKey,proto3" json:"apiKey,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListApiKeysResponse) Reset()         { *m = ListApiKeysResponse{} }
+func (m *ListApiKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApiKeysResponse) ProtoMessage()    {}
+func (*ListApiKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{34}
+}
+
+func (m *ListApiKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApiKeysResponse.Unmarshal(m, b)
+}
+func (m *ListApiKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApiKeysResponse.Marshal(b, m, deterministic)
+}
+func (m *ListApiKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApiKeysResponse.Merge(m, src)
+}
+func (m *ListApiKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApiKeysResponse.Size(m)
+}
+func (m *ListApiKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApiKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApiKeysResponse proto.InternalMessageInfo
+
+func (m *ListApiKeysResponse) GetApiKey() []*ApiKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+type LabelApiKeyRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	KeyId                string   `protobuf:"bytes,2,opt,name=keyId,proto3" json:"keyId,omitempty"`
+	Label                string   `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelApiKeyRequest) Reset()         { *m = LabelApiKeyRequest{} }
+func (m *LabelApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*LabelApiKeyRequest) ProtoMessage()    {}
+func (*LabelApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{35}
+}
+
+func (m *LabelApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelApiKeyRequest.Unmarshal(m, b)
+}
+func (m *LabelApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *LabelApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelApiKeyRequest.Merge(m, src)
+}
+func (m *LabelApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_LabelApiKeyRequest.Size(m)
+}
+func (m *LabelApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelApiKeyRequest proto.InternalMessageInfo
+
+func (m *LabelApiKeyRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *LabelApiKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+func (m *LabelApiKeyRequest) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+type LabelApiKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *LabelApiKeyResponse) Reset()         { *m = LabelApiKeyResponse{} }
+func (m *LabelApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*LabelApiKeyResponse) ProtoMessage()    {}
+func (*LabelApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{36}
+}
+
+func (m *LabelApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LabelApiKeyResponse.Unmarshal(m, b)
+}
+func (m *LabelApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LabelApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *LabelApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LabelApiKeyResponse.Merge(m, src)
+}
+func (m *LabelApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_LabelApiKeyResponse.Size(m)
+}
+func (m *LabelApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LabelApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LabelApiKeyResponse proto.InternalMessageInfo
+
+type RevokeApiKeyRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	KeyId                string   `protobuf:"bytes,2,opt,name=keyId,proto3" json:"keyId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeApiKeyRequest) Reset()         { *m = RevokeApiKeyRequest{} }
+func (m *RevokeApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeApiKeyRequest) ProtoMessage()    {}
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{37}
+}
+
+func (m *RevokeApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeApiKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeApiKeyRequest.Merge(m, src)
+}
+func (m *RevokeApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeApiKeyRequest.Size(m)
+}
+func (m *RevokeApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeApiKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeApiKeyRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *RevokeApiKeyRequest) GetKeyId() string {
+	if m != nil {
+		return m.KeyId
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeApiKeyResponse) Reset()         { *m = RevokeApiKeyResponse{} }
+func (m *RevokeApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeApiKeyResponse) ProtoMessage()    {}
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{38}
+}
+
+func (m *RevokeApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeApiKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeApiKeyResponse.Merge(m, src)
+}
+func (m *RevokeApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeApiKeyResponse.Size(m)
+}
+func (m *RevokeApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeApiKeyResponse proto.InternalMessageInfo
+
+type RequestTrackingRequest struct {
+	TrackeeName          string   `protobuf:"bytes,1,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
+	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestTrackingRequest) Reset()         { *m = RequestTrackingRequest{} }
+func (m *RequestTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*RequestTrackingRequest) ProtoMessage()    {}
+func (*RequestTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{39}
+}
+
+func (m *RequestTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestTrackingRequest.Unmarshal(m, b)
+}
+func (m *RequestTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestTrackingRequest.Marshal(b, m, deterministic)
+}
+func (m *RequestTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestTrackingRequest.Merge(m, src)
+}
+func (m *RequestTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_RequestTrackingRequest.Size(m)
+}
+func (m *RequestTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestTrackingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestTrackingRequest proto.InternalMessageInfo
+
+func (m *RequestTrackingRequest) GetTrackeeName() string {
+	if m != nil {
+		return m.TrackeeName
+	}
+	return ""
+}
+
+func (m *RequestTrackingRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type RequestTrackingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RequestTrackingResponse) Reset()         { *m = RequestTrackingResponse{} }
+func (m *RequestTrackingResponse) String() string { return proto.CompactTextString(m) }
+func (*RequestTrackingResponse) ProtoMessage()    {}
+func (*RequestTrackingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{40}
+}
+
+func (m *RequestTrackingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RequestTrackingResponse.Unmarshal(m, b)
+}
+func (m *RequestTrackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RequestTrackingResponse.Marshal(b, m, deterministic)
+}
+func (m *RequestTrackingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RequestTrackingResponse.Merge(m, src)
+}
+func (m *RequestTrackingResponse) XXX_Size() int {
+	return xxx_messageInfo_RequestTrackingResponse.Size(m)
+}
+func (m *RequestTrackingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RequestTrackingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RequestTrackingResponse proto.InternalMessageInfo
+
+type ApproveTrackingRequest struct {
+	TrackeeName          string   `protobuf:"bytes,1,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
+	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveTrackingRequest) Reset()         { *m = ApproveTrackingRequest{} }
+func (m *ApproveTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*ApproveTrackingRequest) ProtoMessage()    {}
+func (*ApproveTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{41}
+}
+
+func (m *ApproveTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveTrackingRequest.Unmarshal(m, b)
+}
+func (m *ApproveTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveTrackingRequest.Marshal(b, m, deterministic)
+}
+func (m *ApproveTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveTrackingRequest.Merge(m, src)
+}
+func (m *ApproveTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_ApproveTrackingRequest.Size(m)
+}
+func (m *ApproveTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveTrackingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveTrackingRequest proto.InternalMessageInfo
+
+func (m *ApproveTrackingRequest) GetTrackeeName() string {
+	if m != nil {
+		return m.TrackeeName
+	}
+	return ""
+}
+
+func (m *ApproveTrackingRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type ApproveTrackingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveTrackingResponse) Reset()         { *m = ApproveTrackingResponse{} }
+func (m *ApproveTrackingResponse) String() string { return proto.CompactTextString(m) }
+func (*ApproveTrackingResponse) ProtoMessage()    {}
+func (*ApproveTrackingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{42}
+}
+
+func (m *ApproveTrackingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveTrackingResponse.Unmarshal(m, b)
+}
+func (m *ApproveTrackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveTrackingResponse.Marshal(b, m, deterministic)
+}
+func (m *ApproveTrackingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveTrackingResponse.Merge(m, src)
+}
+func (m *ApproveTrackingResponse) XXX_Size() int {
+	return xxx_messageInfo_ApproveTrackingResponse.Size(m)
+}
+func (m *ApproveTrackingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveTrackingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveTrackingResponse proto.InternalMessageInfo
+
+type DenyTrackingRequest struct {
+	TrackeeName          string   `protobuf:"bytes,1,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
+	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DenyTrackingRequest) Reset()         { *m = DenyTrackingRequest{} }
+func (m *DenyTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*DenyTrackingRequest) ProtoMessage()    {}
+func (*DenyTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{43}
+}
+
+func (m *DenyTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DenyTrackingRequest.Unmarshal(m, b)
+}
+func (m *DenyTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DenyTrackingRequest.Marshal(b, m, deterministic)
+}
+func (m *DenyTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenyTrackingRequest.Merge(m, src)
+}
+func (m *DenyTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_DenyTrackingRequest.Size(m)
+}
+func (m *DenyTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenyTrackingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenyTrackingRequest proto.InternalMessageInfo
+
+func (m *DenyTrackingRequest) GetTrackeeName() string {
+	if m != nil {
+		return m.TrackeeName
+	}
+	return ""
+}
+
+func (m *DenyTrackingRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type DenyTrackingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DenyTrackingResponse) Reset()         { *m = DenyTrackingResponse{} }
+func (m *DenyTrackingResponse) String() string { return proto.CompactTextString(m) }
+func (*DenyTrackingResponse) ProtoMessage()    {}
+func (*DenyTrackingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{44}
+}
+
+func (m *DenyTrackingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DenyTrackingResponse.Unmarshal(m, b)
+}
+func (m *DenyTrackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DenyTrackingResponse.Marshal(b, m, deterministic)
+}
+func (m *DenyTrackingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenyTrackingResponse.Merge(m, src)
+}
+func (m *DenyTrackingResponse) XXX_Size() int {
+	return xxx_messageInfo_DenyTrackingResponse.Size(m)
+}
+func (m *DenyTrackingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenyTrackingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenyTrackingResponse proto.InternalMessageInfo
+
+type RevokeTrackingRequest struct {
+	TrackeeName          string   `protobuf:"bytes,1,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
+	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTrackingRequest) Reset()         { *m = RevokeTrackingRequest{} }
+func (m *RevokeTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeTrackingRequest) ProtoMessage()    {}
+func (*RevokeTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{45}
+}
+
+func (m *RevokeTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTrackingRequest.Unmarshal(m, b)
+}
+func (m *RevokeTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTrackingRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTrackingRequest.Merge(m, src)
+}
+func (m *RevokeTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeTrackingRequest.Size(m)
+}
+func (m *RevokeTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTrackingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTrackingRequest proto.InternalMessageInfo
+
+func (m *RevokeTrackingRequest) GetTrackeeName() string {
+	if m != nil {
+		return m.TrackeeName
+	}
+	return ""
+}
+
+func (m *RevokeTrackingRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type RevokeTrackingResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeTrackingResponse) Reset()         { *m = RevokeTrackingResponse{} }
+func (m *RevokeTrackingResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeTrackingResponse) ProtoMessage()    {}
+func (*RevokeTrackingResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{46}
+}
+
+func (m *RevokeTrackingResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeTrackingResponse.Unmarshal(m, b)
+}
+func (m *RevokeTrackingResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeTrackingResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeTrackingResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeTrackingResponse.Merge(m, src)
+}
+func (m *RevokeTrackingResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeTrackingResponse.Size(m)
+}
+func (m *RevokeTrackingResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeTrackingResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeTrackingResponse proto.InternalMessageInfo
+
+type GetWatchersRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetWatchersRequest) Reset()         { *m = GetWatchersRequest{} }
+func (m *GetWatchersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetWatchersRequest) ProtoMessage()    {}
+func (*GetWatchersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{47}
+}
+
+func (m *GetWatchersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWatchersRequest.Unmarshal(m, b)
+}
+func (m *GetWatchersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWatchersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetWatchersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWatchersRequest.Merge(m, src)
+}
+func (m *GetWatchersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetWatchersRequest.Size(m)
+}
+func (m *GetWatchersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWatchersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWatchersRequest proto.InternalMessageInfo
+
+func (m *GetWatchersRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type Watcher struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Timestamp            int64    `protobuf:"varint,2,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Precision            string   `protobuf:"bytes,3,opt,name=precision,proto3" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Watcher) Reset()         { *m = Watcher{} }
+func (m *Watcher) String() string { return proto.CompactTextString(m) }
+func (*Watcher) ProtoMessage()    {}
+func (*Watcher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{48}
+}
+
+func (m *Watcher) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Watcher.Unmarshal(m, b)
+}
+func (m *Watcher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Watcher.Marshal(b, m, deterministic)
+}
+func (m *Watcher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Watcher.Merge(m, src)
+}
+func (m *Watcher) XXX_Size() int {
+	return xxx_messageInfo_Watcher.Size(m)
+}
+func (m *Watcher) XXX_DiscardUnknown() {
+	xxx_messageInfo_Watcher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Watcher proto.InternalMessageInfo
+
+func (m *Watcher) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *Watcher) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *Watcher) GetPrecision() string {
+	if m != nil {
+		return m.Precision
+	}
+	return ""
+}
+
+type GetWatchersResponse struct {
+	Pending              []*Watcher `protobuf:"bytes,1,rep,name=pending,proto3" json:"pending,omitempty"`
+	Approved             []*Watcher `protobuf:"bytes,2,rep,name=approved,proto3" json:"approved,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetWatchersResponse) Reset()         { *m = GetWatchersResponse{} }
+func (m *GetWatchersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWatchersResponse) ProtoMessage()    {}
+func (*GetWatchersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{49}
+}
+
+func (m *GetWatchersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWatchersResponse.Unmarshal(m, b)
+}
+func (m *GetWatchersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWatchersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetWatchersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWatchersResponse.Merge(m, src)
+}
+func (m *GetWatchersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetWatchersResponse.Size(m)
+}
+func (m *GetWatchersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWatchersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetWatchersResponse proto.InternalMessageInfo
+
+func (m *GetWatchersResponse) GetPending() []*Watcher {
+	if m != nil {
+		return m.Pending
+	}
+	return nil
+}
+
+func (m *GetWatchersResponse) GetApproved() []*Watcher {
+	if m != nil {
+		return m.Approved
+	}
+	return nil
+}
+
+// precision is one of "exact", "100m", "1km" or "10km".
+type SetTrackingPrecisionRequest struct {
+	TrackeeName          string   `protobuf:"bytes,1,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
+	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	Precision            string   `protobuf:"bytes,3,opt,name=precision,proto3" json:"precision,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTrackingPrecisionRequest) Reset()         { *m = SetTrackingPrecisionRequest{} }
+func (m *SetTrackingPrecisionRequest) String() string { return proto.CompactTextString(m) }
+func (*SetTrackingPrecisionRequest) ProtoMessage()    {}
+func (*SetTrackingPrecisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{50}
+}
+
+func (m *SetTrackingPrecisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTrackingPrecisionRequest.Unmarshal(m, b)
+}
+func (m *SetTrackingPrecisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTrackingPrecisionRequest.Marshal(b, m, deterministic)
+}
+func (m *SetTrackingPrecisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTrackingPrecisionRequest.Merge(m, src)
+}
+func (m *SetTrackingPrecisionRequest) XXX_Size() int {
+	return xxx_messageInfo_SetTrackingPrecisionRequest.Size(m)
+}
+func (m *SetTrackingPrecisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTrackingPrecisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTrackingPrecisionRequest proto.InternalMessageInfo
+
+func (m *SetTrackingPrecisionRequest) GetTrackeeName() string {
+	if m != nil {
+		return m.TrackeeName
+	}
+	return ""
+}
+
+func (m *SetTrackingPrecisionRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *SetTrackingPrecisionRequest) GetPrecision() string {
+	if m != nil {
+		return m.Precision
+	}
+	return ""
+}
+
+type SetTrackingPrecisionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetTrackingPrecisionResponse) Reset()         { *m = SetTrackingPrecisionResponse{} }
+func (m *SetTrackingPrecisionResponse) String() string { return proto.CompactTextString(m) }
+func (*SetTrackingPrecisionResponse) ProtoMessage()    {}
+func (*SetTrackingPrecisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{51}
+}
+
+func (m *SetTrackingPrecisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetTrackingPrecisionResponse.Unmarshal(m, b)
+}
+func (m *SetTrackingPrecisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetTrackingPrecisionResponse.Marshal(b, m, deterministic)
+}
+func (m *SetTrackingPrecisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetTrackingPrecisionResponse.Merge(m, src)
+}
+func (m *SetTrackingPrecisionResponse) XXX_Size() int {
+	return xxx_messageInfo_SetTrackingPrecisionResponse.Size(m)
+}
+func (m *SetTrackingPrecisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetTrackingPrecisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetTrackingPrecisionResponse proto.InternalMessageInfo
+
+type ShareLink struct {
+	ShareId              string   `protobuf:"bytes,1,opt,name=shareId,proto3" json:"shareId,omitempty"`
+	SessionId            string   `protobuf:"bytes,2,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	ExpiresAt            int64    `protobuf:"varint,4,opt,name=expiresAt,proto3" json:"expiresAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShareLink) Reset()         { *m = ShareLink{} }
+func (m *ShareLink) String() string { return proto.CompactTextString(m) }
+func (*ShareLink) ProtoMessage()    {}
+func (*ShareLink) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{52}
+}
+
+func (m *ShareLink) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShareLink.Unmarshal(m, b)
+}
+func (m *ShareLink) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShareLink.Marshal(b, m, deterministic)
+}
+func (m *ShareLink) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShareLink.Merge(m, src)
+}
+func (m *ShareLink) XXX_Size() int {
+	return xxx_messageInfo_ShareLink.Size(m)
+}
+func (m *ShareLink) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShareLink.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShareLink proto.InternalMessageInfo
+
+func (m *ShareLink) GetShareId() string {
+	if m != nil {
+		return m.ShareId
+	}
+	return ""
+}
+
+func (m *ShareLink) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+func (m *ShareLink) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+func (m *ShareLink) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
+type CreateShareLinkRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	DurationSeconds      int64    `protobuf:"varint,2,opt,name=durationSeconds,proto3" json:"durationSeconds,omitempty"`
+	SessionId            string   `protobuf:"bytes,3,opt,name=sessionId,proto3" json:"sessionId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateShareLinkRequest) Reset()         { *m = CreateShareLinkRequest{} }
+func (m *CreateShareLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateShareLinkRequest) ProtoMessage()    {}
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{53}
+}
+
+func (m *CreateShareLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateShareLinkRequest.Unmarshal(m, b)
+}
+func (m *CreateShareLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateShareLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateShareLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShareLinkRequest.Merge(m, src)
+}
+func (m *CreateShareLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateShareLinkRequest.Size(m)
+}
+func (m *CreateShareLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShareLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShareLinkRequest proto.InternalMessageInfo
+
+func (m *CreateShareLinkRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *CreateShareLinkRequest) GetDurationSeconds() int64 {
+	if m != nil {
+		return m.DurationSeconds
+	}
+	return 0
+}
+
+func (m *CreateShareLinkRequest) GetSessionId() string {
+	if m != nil {
+		return m.SessionId
+	}
+	return ""
+}
+
+type CreateShareLinkResponse struct {
+	ShareLink            *ShareLink `protobuf:"bytes,1,opt,name=shareLink,proto3" json:"shareLink,omitempty"`
+	Token                string     `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *CreateShareLinkResponse) Reset()         { *m = CreateShareLinkResponse{} }
+func (m *CreateShareLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateShareLinkResponse) ProtoMessage()    {}
+func (*CreateShareLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{54}
+}
+
+func (m *CreateShareLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateShareLinkResponse.Unmarshal(m, b)
+}
+func (m *CreateShareLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateShareLinkResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateShareLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateShareLinkResponse.Merge(m, src)
+}
+func (m *CreateShareLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateShareLinkResponse.Size(m)
+}
+func (m *CreateShareLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateShareLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateShareLinkResponse proto.InternalMessageInfo
+
+func (m *CreateShareLinkResponse) GetShareLink() *ShareLink {
+	if m != nil {
+		return m.ShareLink
+	}
+	return nil
+}
+
+func (m *CreateShareLinkResponse) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+type ListShareLinksRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListShareLinksRequest) Reset()         { *m = ListShareLinksRequest{} }
+func (m *ListShareLinksRequest) String() string { return proto.CompactTextString(m) }
+func (*ListShareLinksRequest) ProtoMessage()    {}
+func (*ListShareLinksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{55}
+}
+
+func (m *ListShareLinksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListShareLinksRequest.Unmarshal(m, b)
+}
+func (m *ListShareLinksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListShareLinksRequest.Marshal(b, m, deterministic)
+}
+func (m *ListShareLinksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListShareLinksRequest.Merge(m, src)
+}
+func (m *ListShareLinksRequest) XXX_Size() int {
+	return xxx_messageInfo_ListShareLinksRequest.Size(m)
+}
+func (m *ListShareLinksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListShareLinksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListShareLinksRequest proto.InternalMessageInfo
+
+func (m *ListShareLinksRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type ListShareLinksResponse struct {
+	ShareLink            []*ShareLink `protobuf:"bytes,1,rep,name=shareLink,proto3" json:"shareLink,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListShareLinksResponse) Reset()         { *m = ListShareLinksResponse{} }
+func (m *ListShareLinksResponse) String() string { return proto.CompactTextString(m) }
+func (*ListShareLinksResponse) ProtoMessage()    {}
+func (*ListShareLinksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{56}
+}
+
+func (m *ListShareLinksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListShareLinksResponse.Unmarshal(m, b)
+}
+func (m *ListShareLinksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListShareLinksResponse.Marshal(b, m, deterministic)
+}
+func (m *ListShareLinksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListShareLinksResponse.Merge(m, src)
+}
+func (m *ListShareLinksResponse) XXX_Size() int {
+	return xxx_messageInfo_ListShareLinksResponse.Size(m)
+}
+func (m *ListShareLinksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListShareLinksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListShareLinksResponse proto.InternalMessageInfo
+
+func (m *ListShareLinksResponse) GetShareLink() []*ShareLink {
+	if m != nil {
+		return m.ShareLink
+	}
+	return nil
+}
+
+type RevokeShareLinkRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	ShareId              string   `protobuf:"bytes,2,opt,name=shareId,proto3" json:"shareId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeShareLinkRequest) Reset()         { *m = RevokeShareLinkRequest{} }
+func (m *RevokeShareLinkRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeShareLinkRequest) ProtoMessage()    {}
+func (*RevokeShareLinkRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{57}
+}
+
+func (m *RevokeShareLinkRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeShareLinkRequest.Unmarshal(m, b)
+}
+func (m *RevokeShareLinkRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeShareLinkRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeShareLinkRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeShareLinkRequest.Merge(m, src)
+}
+func (m *RevokeShareLinkRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeShareLinkRequest.Size(m)
+}
+func (m *RevokeShareLinkRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeShareLinkRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeShareLinkRequest proto.InternalMessageInfo
+
+func (m *RevokeShareLinkRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *RevokeShareLinkRequest) GetShareId() string {
+	if m != nil {
+		return m.ShareId
+	}
+	return ""
+}
+
+type RevokeShareLinkResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeShareLinkResponse) Reset()         { *m = RevokeShareLinkResponse{} }
+func (m *RevokeShareLinkResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeShareLinkResponse) ProtoMessage()    {}
+func (*RevokeShareLinkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{58}
+}
+
+func (m *RevokeShareLinkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeShareLinkResponse.Unmarshal(m, b)
+}
+func (m *RevokeShareLinkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeShareLinkResponse.Marshal(b, m, deterministic)
+}
+func (m *RevokeShareLinkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeShareLinkResponse.Merge(m, src)
+}
+func (m *RevokeShareLinkResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeShareLinkResponse.Size(m)
+}
+func (m *RevokeShareLinkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeShareLinkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeShareLinkResponse proto.InternalMessageInfo
+
+type Group struct {
+	GroupName            string   `protobuf:"bytes,1,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	Member               []string `protobuf:"bytes,2,rep,name=member,proto3" json:"member,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,3,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Group) Reset()         { *m = Group{} }
+func (m *Group) String() string { return proto.CompactTextString(m) }
+func (*Group) ProtoMessage()    {}
+func (*Group) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{59}
+}
+
+func (m *Group) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Group.Unmarshal(m, b)
+}
+func (m *Group) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Group.Marshal(b, m, deterministic)
+}
+func (m *Group) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Group.Merge(m, src)
+}
+func (m *Group) XXX_Size() int {
+	return xxx_messageInfo_Group.Size(m)
+}
+func (m *Group) XXX_DiscardUnknown() {
+	xxx_messageInfo_Group.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Group proto.InternalMessageInfo
+
+func (m *Group) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+func (m *Group) GetMember() []string {
+	if m != nil {
+		return m.Member
+	}
+	return nil
+}
+
+func (m *Group) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type CreateGroupRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	GroupName            string   `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGroupRequest) Reset()         { *m = CreateGroupRequest{} }
+func (m *CreateGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateGroupRequest) ProtoMessage()    {}
+func (*CreateGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{60}
+}
+
+func (m *CreateGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGroupRequest.Unmarshal(m, b)
+}
+func (m *CreateGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGroupRequest.Merge(m, src)
+}
+func (m *CreateGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateGroupRequest.Size(m)
+}
+func (m *CreateGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGroupRequest proto.InternalMessageInfo
+
+func (m *CreateGroupRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *CreateGroupRequest) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+type CreateGroupResponse struct {
+	Group                *Group   `protobuf:"bytes,1,opt,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateGroupResponse) Reset()         { *m = CreateGroupResponse{} }
+func (m *CreateGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateGroupResponse) ProtoMessage()    {}
+func (*CreateGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{61}
+}
+
+func (m *CreateGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateGroupResponse.Unmarshal(m, b)
+}
+func (m *CreateGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateGroupResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateGroupResponse.Merge(m, src)
+}
+func (m *CreateGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateGroupResponse.Size(m)
+}
+func (m *CreateGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateGroupResponse proto.InternalMessageInfo
+
+func (m *CreateGroupResponse) GetGroup() *Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+type DeleteGroupRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	GroupName            string   `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGroupRequest) Reset()         { *m = DeleteGroupRequest{} }
+func (m *DeleteGroupRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteGroupRequest) ProtoMessage()    {}
+func (*DeleteGroupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{62}
+}
+
+func (m *DeleteGroupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGroupRequest.Unmarshal(m, b)
+}
+func (m *DeleteGroupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGroupRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteGroupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGroupRequest.Merge(m, src)
+}
+func (m *DeleteGroupRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteGroupRequest.Size(m)
+}
+func (m *DeleteGroupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGroupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGroupRequest proto.InternalMessageInfo
+
+func (m *DeleteGroupRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *DeleteGroupRequest) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+type DeleteGroupResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteGroupResponse) Reset()         { *m = DeleteGroupResponse{} }
+func (m *DeleteGroupResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteGroupResponse) ProtoMessage()    {}
+func (*DeleteGroupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{63}
+}
+
+func (m *DeleteGroupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteGroupResponse.Unmarshal(m, b)
+}
+func (m *DeleteGroupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteGroupResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteGroupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteGroupResponse.Merge(m, src)
+}
+func (m *DeleteGroupResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteGroupResponse.Size(m)
+}
+func (m *DeleteGroupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteGroupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteGroupResponse proto.InternalMessageInfo
+
+type ListGroupsRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGroupsRequest) Reset()         { *m = ListGroupsRequest{} }
+func (m *ListGroupsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGroupsRequest) ProtoMessage()    {}
+func (*ListGroupsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{64}
+}
+
+func (m *ListGroupsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupsRequest.Unmarshal(m, b)
+}
+func (m *ListGroupsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListGroupsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupsRequest.Merge(m, src)
+}
+func (m *ListGroupsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGroupsRequest.Size(m)
+}
+func (m *ListGroupsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGroupsRequest proto.InternalMessageInfo
+
+func (m *ListGroupsRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type ListGroupsResponse struct {
+	Group                []*Group `protobuf:"bytes,1,rep,name=group,proto3" json:"group,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGroupsResponse) Reset()         { *m = ListGroupsResponse{} }
+func (m *ListGroupsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGroupsResponse) ProtoMessage()    {}
+func (*ListGroupsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{65}
+}
+
+func (m *ListGroupsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGroupsResponse.Unmarshal(m, b)
+}
+func (m *ListGroupsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGroupsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListGroupsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGroupsResponse.Merge(m, src)
+}
+func (m *ListGroupsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGroupsResponse.Size(m)
+}
+func (m *ListGroupsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGroupsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGroupsResponse proto.InternalMessageInfo
+
+func (m *ListGroupsResponse) GetGroup() []*Group {
+	if m != nil {
+		return m.Group
+	}
+	return nil
+}
+
+type AddGroupMemberRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	GroupName            string   `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	TrackeeName          string   `protobuf:"bytes,3,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddGroupMemberRequest) Reset()         { *m = AddGroupMemberRequest{} }
+func (m *AddGroupMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*AddGroupMemberRequest) ProtoMessage()    {}
+func (*AddGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{66}
+}
+
+func (m *AddGroupMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddGroupMemberRequest.Unmarshal(m, b)
+}
+func (m *AddGroupMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddGroupMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *AddGroupMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddGroupMemberRequest.Merge(m, src)
+}
+func (m *AddGroupMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_AddGroupMemberRequest.Size(m)
+}
+func (m *AddGroupMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddGroupMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddGroupMemberRequest proto.InternalMessageInfo
+
+func (m *AddGroupMemberRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *AddGroupMemberRequest) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+func (m *AddGroupMemberRequest) GetTrackeeName() string {
+	if m != nil {
+		return m.TrackeeName
+	}
+	return ""
+}
+
+type AddGroupMemberResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddGroupMemberResponse) Reset()         { *m = AddGroupMemberResponse{} }
+func (m *AddGroupMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*AddGroupMemberResponse) ProtoMessage()    {}
+func (*AddGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{67}
+}
+
+func (m *AddGroupMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddGroupMemberResponse.Unmarshal(m, b)
+}
+func (m *AddGroupMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddGroupMemberResponse.Marshal(b, m, deterministic)
+}
+func (m *AddGroupMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddGroupMemberResponse.Merge(m, src)
+}
+func (m *AddGroupMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_AddGroupMemberResponse.Size(m)
+}
+func (m *AddGroupMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddGroupMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddGroupMemberResponse proto.InternalMessageInfo
+
+type RemoveGroupMemberRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	GroupName            string   `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	TrackeeName          string   `protobuf:"bytes,3,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveGroupMemberRequest) Reset()         { *m = RemoveGroupMemberRequest{} }
+func (m *RemoveGroupMemberRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveGroupMemberRequest) ProtoMessage()    {}
+func (*RemoveGroupMemberRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{68}
+}
+
+func (m *RemoveGroupMemberRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveGroupMemberRequest.Unmarshal(m, b)
+}
+func (m *RemoveGroupMemberRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveGroupMemberRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveGroupMemberRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveGroupMemberRequest.Merge(m, src)
+}
+func (m *RemoveGroupMemberRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveGroupMemberRequest.Size(m)
+}
+func (m *RemoveGroupMemberRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveGroupMemberRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveGroupMemberRequest proto.InternalMessageInfo
+
+func (m *RemoveGroupMemberRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *RemoveGroupMemberRequest) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+func (m *RemoveGroupMemberRequest) GetTrackeeName() string {
+	if m != nil {
+		return m.TrackeeName
+	}
+	return ""
+}
+
+type RemoveGroupMemberResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveGroupMemberResponse) Reset()         { *m = RemoveGroupMemberResponse{} }
+func (m *RemoveGroupMemberResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveGroupMemberResponse) ProtoMessage()    {}
+func (*RemoveGroupMemberResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{69}
+}
+
+func (m *RemoveGroupMemberResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveGroupMemberResponse.Unmarshal(m, b)
+}
+func (m *RemoveGroupMemberResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveGroupMemberResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveGroupMemberResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveGroupMemberResponse.Merge(m, src)
+}
+func (m *RemoveGroupMemberResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveGroupMemberResponse.Size(m)
+}
+func (m *RemoveGroupMemberResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveGroupMemberResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveGroupMemberResponse proto.InternalMessageInfo
+
+type StartGroupTrackingRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	GroupName            string   `protobuf:"bytes,2,opt,name=groupName,proto3" json:"groupName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StartGroupTrackingRequest) Reset()         { *m = StartGroupTrackingRequest{} }
+func (m *StartGroupTrackingRequest) String() string { return proto.CompactTextString(m) }
+func (*StartGroupTrackingRequest) ProtoMessage()    {}
+func (*StartGroupTrackingRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{70}
+}
+
+func (m *StartGroupTrackingRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StartGroupTrackingRequest.Unmarshal(m, b)
+}
+func (m *StartGroupTrackingRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StartGroupTrackingRequest.Marshal(b, m, deterministic)
+}
+func (m *StartGroupTrackingRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StartGroupTrackingRequest.Merge(m, src)
+}
+func (m *StartGroupTrackingRequest) XXX_Size() int {
+	return xxx_messageInfo_StartGroupTrackingRequest.Size(m)
+}
+func (m *StartGroupTrackingRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_StartGroupTrackingRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StartGroupTrackingRequest proto.InternalMessageInfo
+
+func (m *StartGroupTrackingRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *StartGroupTrackingRequest) GetGroupName() string {
+	if m != nil {
+		return m.GroupName
+	}
+	return ""
+}
+
+type DeleteAccountRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAccountRequest) Reset()         { *m = DeleteAccountRequest{} }
+func (m *DeleteAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountRequest) ProtoMessage()    {}
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{71}
+}
+
+func (m *DeleteAccountRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountRequest.Unmarshal(m, b)
+}
+func (m *DeleteAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountRequest.Merge(m, src)
+}
+func (m *DeleteAccountRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountRequest.Size(m)
+}
+func (m *DeleteAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAccountRequest proto.InternalMessageInfo
+
+func (m *DeleteAccountRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *DeleteAccountRequest) GetPassword() string {
+	if m != nil {
+		return m.Password
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteAccountResponse) Reset()         { *m = DeleteAccountResponse{} }
+func (m *DeleteAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteAccountResponse) ProtoMessage()    {}
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{72}
+}
+
+func (m *DeleteAccountResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteAccountResponse.Unmarshal(m, b)
+}
+func (m *DeleteAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteAccountResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteAccountResponse.Merge(m, src)
+}
+func (m *DeleteAccountResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteAccountResponse.Size(m)
+}
+func (m *DeleteAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteAccountResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteAccountResponse proto.InternalMessageInfo
+
+type ExportMyDataRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportMyDataRequest) Reset()         { *m = ExportMyDataRequest{} }
+func (m *ExportMyDataRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataRequest) ProtoMessage()    {}
+func (*ExportMyDataRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{73}
+}
+
+func (m *ExportMyDataRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataRequest.Unmarshal(m, b)
+}
+func (m *ExportMyDataRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataRequest.Marshal(b, m, deterministic)
+}
+func (m *ExportMyDataRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataRequest.Merge(m, src)
+}
+func (m *ExportMyDataRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataRequest.Size(m)
+}
+func (m *ExportMyDataRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMyDataRequest proto.InternalMessageInfo
+
+func (m *ExportMyDataRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type ExportMyDataResponse struct {
+	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	ContentType          string   `protobuf:"bytes,2,opt,name=contentType,proto3" json:"contentType,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportMyDataResponse) Reset()         { *m = ExportMyDataResponse{} }
+func (m *ExportMyDataResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportMyDataResponse) ProtoMessage()    {}
+func (*ExportMyDataResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{74}
+}
+
+func (m *ExportMyDataResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportMyDataResponse.Unmarshal(m, b)
+}
+func (m *ExportMyDataResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportMyDataResponse.Marshal(b, m, deterministic)
+}
+func (m *ExportMyDataResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportMyDataResponse.Merge(m, src)
+}
+func (m *ExportMyDataResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportMyDataResponse.Size(m)
+}
+func (m *ExportMyDataResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportMyDataResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportMyDataResponse proto.InternalMessageInfo
+
+func (m *ExportMyDataResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *ExportMyDataResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+type SessionSummary struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartedAt            int64    `protobuf:"varint,2,opt,name=startedAt,proto3" json:"startedAt,omitempty"`
+	EndedAt              int64    `protobuf:"varint,3,opt,name=endedAt,proto3" json:"endedAt,omitempty"`
+	Points               int64    `protobuf:"varint,4,opt,name=points,proto3" json:"points,omitempty"`
+	Distance             float64  `protobuf:"fixed64,5,opt,name=distance,proto3" json:"distance,omitempty"`
+	MinLatitude          float64  `protobuf:"fixed64,6,opt,name=minLatitude,proto3" json:"minLatitude,omitempty"`
+	MinLongitude         float64  `protobuf:"fixed64,7,opt,name=minLongitude,proto3" json:"minLongitude,omitempty"`
+	MaxLatitude          float64  `protobuf:"fixed64,8,opt,name=maxLatitude,proto3" json:"maxLatitude,omitempty"`
+	MaxLongitude         float64  `protobuf:"fixed64,9,opt,name=maxLongitude,proto3" json:"maxLongitude,omitempty"`
+	Purged               bool     `protobuf:"varint,10,opt,name=purged,proto3" json:"purged,omitempty"`
+	Compacted            bool     `protobuf:"varint,11,opt,name=compacted,proto3" json:"compacted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SessionSummary) Reset()         { *m = SessionSummary{} }
+func (m *SessionSummary) String() string { return proto.CompactTextString(m) }
+func (*SessionSummary) ProtoMessage()    {}
+func (*SessionSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{75}
+}
+
+func (m *SessionSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SessionSummary.Unmarshal(m, b)
+}
+func (m *SessionSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SessionSummary.Marshal(b, m, deterministic)
+}
+func (m *SessionSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SessionSummary.Merge(m, src)
+}
+func (m *SessionSummary) XXX_Size() int {
+	return xxx_messageInfo_SessionSummary.Size(m)
+}
+func (m *SessionSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_SessionSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SessionSummary proto.InternalMessageInfo
+
+func (m *SessionSummary) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *SessionSummary) GetStartedAt() int64 {
+	if m != nil {
+		return m.StartedAt
+	}
+	return 0
+}
+
+func (m *SessionSummary) GetEndedAt() int64 {
+	if m != nil {
+		return m.EndedAt
+	}
+	return 0
+}
+
+func (m *SessionSummary) GetPoints() int64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+func (m *SessionSummary) GetDistance() float64 {
+	if m != nil {
+		return m.Distance
+	}
+	return 0
+}
+
+func (m *SessionSummary) GetMinLatitude() float64 {
+	if m != nil {
+		return m.MinLatitude
+	}
+	return 0
+}
+
+func (m *SessionSummary) GetMinLongitude() float64 {
+	if m != nil {
+		return m.MinLongitude
+	}
+	return 0
+}
+
+func (m *SessionSummary) GetMaxLatitude() float64 {
+	if m != nil {
+		return m.MaxLatitude
+	}
+	return 0
+}
+
+func (m *SessionSummary) GetMaxLongitude() float64 {
+	if m != nil {
+		return m.MaxLongitude
+	}
+	return 0
+}
+
+func (m *SessionSummary) GetPurged() bool {
+	if m != nil {
+		return m.Purged
+	}
+	return false
+}
+
+func (m *SessionSummary) GetCompacted() bool {
+	if m != nil {
+		return m.Compacted
+	}
+	return false
+}
+
+// Locations within radius meters of the center are hidden from watchers,
+// or snapped to the edge when mode is "snap".
+type PrivacyZone struct {
+	ZoneId               string   `protobuf:"bytes,1,opt,name=zoneId,proto3" json:"zoneId,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Latitude             float64  `protobuf:"fixed64,3,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude            float64  `protobuf:"fixed64,4,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	Radius               float64  `protobuf:"fixed64,5,opt,name=radius,proto3" json:"radius,omitempty"`
+	Mode                 string   `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PrivacyZone) Reset()         { *m = PrivacyZone{} }
+func (m *PrivacyZone) String() string { return proto.CompactTextString(m) }
+func (*PrivacyZone) ProtoMessage()    {}
+func (*PrivacyZone) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{76}
+}
+
+func (m *PrivacyZone) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PrivacyZone.Unmarshal(m, b)
+}
+func (m *PrivacyZone) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PrivacyZone.Marshal(b, m, deterministic)
+}
+func (m *PrivacyZone) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PrivacyZone.Merge(m, src)
+}
+func (m *PrivacyZone) XXX_Size() int {
+	return xxx_messageInfo_PrivacyZone.Size(m)
+}
+func (m *PrivacyZone) XXX_DiscardUnknown() {
+	xxx_messageInfo_PrivacyZone.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PrivacyZone proto.InternalMessageInfo
+
+func (m *PrivacyZone) GetZoneId() string {
+	if m != nil {
+		return m.ZoneId
+	}
+	return ""
+}
+
+func (m *PrivacyZone) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *PrivacyZone) GetLatitude() float64 {
+	if m != nil {
+		return m.Latitude
+	}
+	return 0
+}
+
+func (m *PrivacyZone) GetLongitude() float64 {
+	if m != nil {
+		return m.Longitude
+	}
+	return 0
+}
+
+func (m *PrivacyZone) GetRadius() float64 {
+	if m != nil {
+		return m.Radius
+	}
+	return 0
+}
+
+func (m *PrivacyZone) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+type CreatePrivacyZoneRequest struct {
+	UserName             string       `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Zone                 *PrivacyZone `protobuf:"bytes,2,opt,name=zone,proto3" json:"zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreatePrivacyZoneRequest) Reset()         { *m = CreatePrivacyZoneRequest{} }
+func (m *CreatePrivacyZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*CreatePrivacyZoneRequest) ProtoMessage()    {}
+func (*CreatePrivacyZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{77}
+}
+
+func (m *CreatePrivacyZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePrivacyZoneRequest.Unmarshal(m, b)
+}
+func (m *CreatePrivacyZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePrivacyZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *CreatePrivacyZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePrivacyZoneRequest.Merge(m, src)
+}
+func (m *CreatePrivacyZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_CreatePrivacyZoneRequest.Size(m)
+}
+func (m *CreatePrivacyZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePrivacyZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePrivacyZoneRequest proto.InternalMessageInfo
+
+func (m *CreatePrivacyZoneRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *CreatePrivacyZoneRequest) GetZone() *PrivacyZone {
+	if m != nil {
+		return m.Zone
+	}
+	return nil
+}
+
+type CreatePrivacyZoneResponse struct {
+	Zone                 *PrivacyZone `protobuf:"bytes,1,opt,name=zone,proto3" json:"zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreatePrivacyZoneResponse) Reset()         { *m = CreatePrivacyZoneResponse{} }
+func (m *CreatePrivacyZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*CreatePrivacyZoneResponse) ProtoMessage()    {}
+func (*CreatePrivacyZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{78}
+}
+
+func (m *CreatePrivacyZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreatePrivacyZoneResponse.Unmarshal(m, b)
+}
+func (m *CreatePrivacyZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreatePrivacyZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *CreatePrivacyZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreatePrivacyZoneResponse.Merge(m, src)
+}
+func (m *CreatePrivacyZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_CreatePrivacyZoneResponse.Size(m)
+}
+func (m *CreatePrivacyZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreatePrivacyZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreatePrivacyZoneResponse proto.InternalMessageInfo
+
+func (m *CreatePrivacyZoneResponse) GetZone() *PrivacyZone {
+	if m != nil {
+		return m.Zone
+	}
+	return nil
+}
+
+type ListPrivacyZonesRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPrivacyZonesRequest) Reset()         { *m = ListPrivacyZonesRequest{} }
+func (m *ListPrivacyZonesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPrivacyZonesRequest) ProtoMessage()    {}
+func (*ListPrivacyZonesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{79}
+}
+
+func (m *ListPrivacyZonesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPrivacyZonesRequest.Unmarshal(m, b)
+}
+func (m *ListPrivacyZonesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPrivacyZonesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPrivacyZonesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPrivacyZonesRequest.Merge(m, src)
+}
+func (m *ListPrivacyZonesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPrivacyZonesRequest.Size(m)
+}
+func (m *ListPrivacyZonesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPrivacyZonesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPrivacyZonesRequest proto.InternalMessageInfo
+
+func (m *ListPrivacyZonesRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type ListPrivacyZonesResponse struct {
+	Zone                 []*PrivacyZone `protobuf:"bytes,1,rep,name=zone,proto3" json:"zone,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListPrivacyZonesResponse) Reset()         { *m = ListPrivacyZonesResponse{} }
+func (m *ListPrivacyZonesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPrivacyZonesResponse) ProtoMessage()    {}
+func (*ListPrivacyZonesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{80}
+}
+
+func (m *ListPrivacyZonesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPrivacyZonesResponse.Unmarshal(m, b)
+}
+func (m *ListPrivacyZonesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPrivacyZonesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPrivacyZonesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPrivacyZonesResponse.Merge(m, src)
+}
+func (m *ListPrivacyZonesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPrivacyZonesResponse.Size(m)
+}
+func (m *ListPrivacyZonesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPrivacyZonesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPrivacyZonesResponse proto.InternalMessageInfo
+
+func (m *ListPrivacyZonesResponse) GetZone() []*PrivacyZone {
+	if m != nil {
+		return m.Zone
+	}
+	return nil
+}
+
+type DeletePrivacyZoneRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	ZoneId               string   `protobuf:"bytes,2,opt,name=zoneId,proto3" json:"zoneId,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePrivacyZoneRequest) Reset()         { *m = DeletePrivacyZoneRequest{} }
+func (m *DeletePrivacyZoneRequest) String() string { return proto.CompactTextString(m) }
+func (*DeletePrivacyZoneRequest) ProtoMessage()    {}
+func (*DeletePrivacyZoneRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{81}
+}
+
+func (m *DeletePrivacyZoneRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePrivacyZoneRequest.Unmarshal(m, b)
+}
+func (m *DeletePrivacyZoneRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePrivacyZoneRequest.Marshal(b, m, deterministic)
+}
+func (m *DeletePrivacyZoneRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePrivacyZoneRequest.Merge(m, src)
+}
+func (m *DeletePrivacyZoneRequest) XXX_Size() int {
+	return xxx_messageInfo_DeletePrivacyZoneRequest.Size(m)
+}
+func (m *DeletePrivacyZoneRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePrivacyZoneRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePrivacyZoneRequest proto.InternalMessageInfo
+
+func (m *DeletePrivacyZoneRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *DeletePrivacyZoneRequest) GetZoneId() string {
+	if m != nil {
+		return m.ZoneId
+	}
+	return ""
+}
+
+type DeletePrivacyZoneResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeletePrivacyZoneResponse) Reset()         { *m = DeletePrivacyZoneResponse{} }
+func (m *DeletePrivacyZoneResponse) String() string { return proto.CompactTextString(m) }
+func (*DeletePrivacyZoneResponse) ProtoMessage()    {}
+func (*DeletePrivacyZoneResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{82}
+}
+
+func (m *DeletePrivacyZoneResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeletePrivacyZoneResponse.Unmarshal(m, b)
+}
+func (m *DeletePrivacyZoneResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeletePrivacyZoneResponse.Marshal(b, m, deterministic)
+}
+func (m *DeletePrivacyZoneResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeletePrivacyZoneResponse.Merge(m, src)
+}
+func (m *DeletePrivacyZoneResponse) XXX_Size() int {
+	return xxx_messageInfo_DeletePrivacyZoneResponse.Size(m)
+}
+func (m *DeletePrivacyZoneResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeletePrivacyZoneResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeletePrivacyZoneResponse proto.InternalMessageInfo
+
+// An access to the data of target, outcome is "allowed" or "denied".
+type AuditEntry struct {
+	Timestamp            int64    `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Actor                string   `protobuf:"bytes,2,opt,name=actor,proto3" json:"actor,omitempty"`
+	Target               string   `protobuf:"bytes,3,opt,name=target,proto3" json:"target,omitempty"`
+	Action               string   `protobuf:"bytes,4,opt,name=action,proto3" json:"action,omitempty"`
+	Transport            string   `protobuf:"bytes,5,opt,name=transport,proto3" json:"transport,omitempty"`
+	Outcome              string   `protobuf:"bytes,6,opt,name=outcome,proto3" json:"outcome,omitempty"`
+	Reason               string   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{83}
+}
+
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntry.Unmarshal(m, b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+}
+func (m *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(m, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return xxx_messageInfo_AuditEntry.Size(m)
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *AuditEntry) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetAction() string {
+	if m != nil {
+		return m.Action
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetTransport() string {
+	if m != nil {
+		return m.Transport
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetOutcome() string {
+	if m != nil {
+		return m.Outcome
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// Entries are returned newest first, since is a unix time and 0 places no
+// limit.
+type GetAuditLogRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Since                int64    `protobuf:"varint,2,opt,name=since,proto3" json:"since,omitempty"`
+	Limit                int32    `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetAuditLogRequest) Reset()         { *m = GetAuditLogRequest{} }
+func (m *GetAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditLogRequest) ProtoMessage()    {}
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{84}
+}
+
+func (m *GetAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditLogRequest.Unmarshal(m, b)
+}
+func (m *GetAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditLogRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditLogRequest.Merge(m, src)
+}
+func (m *GetAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditLogRequest.Size(m)
+}
+func (m *GetAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditLogRequest proto.InternalMessageInfo
+
+func (m *GetAuditLogRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *GetAuditLogRequest) GetSince() int64 {
+	if m != nil {
+		return m.Since
+	}
+	return 0
+}
+
+func (m *GetAuditLogRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetAuditLogResponse struct {
+	Entry                []*AuditEntry `protobuf:"bytes,1,rep,name=entry,proto3" json:"entry,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetAuditLogResponse) Reset()         { *m = GetAuditLogResponse{} }
+func (m *GetAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditLogResponse) ProtoMessage()    {}
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{85}
+}
+
+func (m *GetAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditLogResponse.Unmarshal(m, b)
+}
+func (m *GetAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditLogResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditLogResponse.Merge(m, src)
+}
+func (m *GetAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditLogResponse.Size(m)
+}
+func (m *GetAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditLogResponse proto.InternalMessageInfo
+
+func (m *GetAuditLogResponse) GetEntry() []*AuditEntry {
+	if m != nil {
+		return m.Entry
+	}
+	return nil
+}
+
+type User struct {
+	UserId               int64    `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	UserName             string   `protobuf:"bytes,2,opt,name=userName,proto3" json:"userName,omitempty"`
+	Role                 string   `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	Trackable            bool     `protobuf:"varint,4,opt,name=trackable,proto3" json:"trackable,omitempty"`
+	Disabled             bool     `protobuf:"varint,5,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *User) Reset()         { *m = User{} }
+func (m *User) String() string { return proto.CompactTextString(m) }
+func (*User) ProtoMessage()    {}
+func (*User) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{86}
+}
+
+func (m *User) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_User.Unmarshal(m, b)
+}
+func (m *User) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_User.Marshal(b, m, deterministic)
+}
+func (m *User) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_User.Merge(m, src)
+}
+func (m *User) XXX_Size() int {
+	return xxx_messageInfo_User.Size(m)
+}
+func (m *User) XXX_DiscardUnknown() {
+	xxx_messageInfo_User.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_User proto.InternalMessageInfo
+
+func (m *User) GetUserId() int64 {
+	if m != nil {
+		return m.UserId
+	}
+	return 0
+}
+
+func (m *User) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *User) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *User) GetTrackable() bool {
+	if m != nil {
+		return m.Trackable
+	}
+	return false
+}
+
+func (m *User) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+type ListUsersRequest struct {
+	PageToken            string   `protobuf:"bytes,1,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	PageSize             int32    `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUsersRequest) Reset()         { *m = ListUsersRequest{} }
+func (m *ListUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUsersRequest) ProtoMessage()    {}
+func (*ListUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{87}
+}
+
+func (m *ListUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersRequest.Unmarshal(m, b)
+}
+func (m *ListUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersRequest.Merge(m, src)
+}
+func (m *ListUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUsersRequest.Size(m)
+}
+func (m *ListUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersRequest proto.InternalMessageInfo
+
+func (m *ListUsersRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+func (m *ListUsersRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+type ListUsersResponse struct {
+	User                 []*User  `protobuf:"bytes,1,rep,name=user,proto3" json:"user,omitempty"`
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUsersResponse) Reset()         { *m = ListUsersResponse{} }
+func (m *ListUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUsersResponse) ProtoMessage()    {}
+func (*ListUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{88}
+}
+
+func (m *ListUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUsersResponse.Unmarshal(m, b)
+}
+func (m *ListUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUsersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUsersResponse.Merge(m, src)
+}
+func (m *ListUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUsersResponse.Size(m)
+}
+func (m *ListUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUsersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUsersResponse proto.InternalMessageInfo
+
+func (m *ListUsersResponse) GetUser() []*User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+func (m *ListUsersResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type DisableUserRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableUserRequest) Reset()         { *m = DisableUserRequest{} }
+func (m *DisableUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DisableUserRequest) ProtoMessage()    {}
+func (*DisableUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{89}
+}
+
+func (m *DisableUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableUserRequest.Unmarshal(m, b)
+}
+func (m *DisableUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableUserRequest.Marshal(b, m, deterministic)
+}
+func (m *DisableUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableUserRequest.Merge(m, src)
+}
+func (m *DisableUserRequest) XXX_Size() int {
+	return xxx_messageInfo_DisableUserRequest.Size(m)
+}
+func (m *DisableUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableUserRequest proto.InternalMessageInfo
+
+func (m *DisableUserRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type DisableUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DisableUserResponse) Reset()         { *m = DisableUserResponse{} }
+func (m *DisableUserResponse) String() string { return proto.CompactTextString(m) }
+func (*DisableUserResponse) ProtoMessage()    {}
+func (*DisableUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{90}
+}
+
+func (m *DisableUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DisableUserResponse.Unmarshal(m, b)
+}
+func (m *DisableUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DisableUserResponse.Marshal(b, m, deterministic)
+}
+func (m *DisableUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DisableUserResponse.Merge(m, src)
+}
+func (m *DisableUserResponse) XXX_Size() int {
+	return xxx_messageInfo_DisableUserResponse.Size(m)
+}
+func (m *DisableUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DisableUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DisableUserResponse proto.InternalMessageInfo
+
+type EnableUserRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnableUserRequest) Reset()         { *m = EnableUserRequest{} }
+func (m *EnableUserRequest) String() string { return proto.CompactTextString(m) }
+func (*EnableUserRequest) ProtoMessage()    {}
+func (*EnableUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{91}
+}
+
+func (m *EnableUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnableUserRequest.Unmarshal(m, b)
+}
+func (m *EnableUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnableUserRequest.Marshal(b, m, deterministic)
+}
+func (m *EnableUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableUserRequest.Merge(m, src)
+}
+func (m *EnableUserRequest) XXX_Size() int {
+	return xxx_messageInfo_EnableUserRequest.Size(m)
+}
+func (m *EnableUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableUserRequest proto.InternalMessageInfo
+
+func (m *EnableUserRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type EnableUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *EnableUserResponse) Reset()         { *m = EnableUserResponse{} }
+func (m *EnableUserResponse) String() string { return proto.CompactTextString(m) }
+func (*EnableUserResponse) ProtoMessage()    {}
+func (*EnableUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{92}
+}
+
+func (m *EnableUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnableUserResponse.Unmarshal(m, b)
+}
+func (m *EnableUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnableUserResponse.Marshal(b, m, deterministic)
+}
+func (m *EnableUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnableUserResponse.Merge(m, src)
+}
+func (m *EnableUserResponse) XXX_Size() int {
+	return xxx_messageInfo_EnableUserResponse.Size(m)
+}
+func (m *EnableUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnableUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnableUserResponse proto.InternalMessageInfo
+
+type SetUserRoleRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Role                 string   `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserRoleRequest) Reset()         { *m = SetUserRoleRequest{} }
+func (m *SetUserRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetUserRoleRequest) ProtoMessage()    {}
+func (*SetUserRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{93}
+}
+
+func (m *SetUserRoleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserRoleRequest.Unmarshal(m, b)
+}
+func (m *SetUserRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserRoleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetUserRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRoleRequest.Merge(m, src)
+}
+func (m *SetUserRoleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetUserRoleRequest.Size(m)
+}
+func (m *SetUserRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserRoleRequest proto.InternalMessageInfo
+
+func (m *SetUserRoleRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *SetUserRoleRequest) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+type SetUserRoleResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetUserRoleResponse) Reset()         { *m = SetUserRoleResponse{} }
+func (m *SetUserRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetUserRoleResponse) ProtoMessage()    {}
+func (*SetUserRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{94}
+}
+
+func (m *SetUserRoleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetUserRoleResponse.Unmarshal(m, b)
+}
+func (m *SetUserRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetUserRoleResponse.Marshal(b, m, deterministic)
+}
+func (m *SetUserRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetUserRoleResponse.Merge(m, src)
+}
+func (m *SetUserRoleResponse) XXX_Size() int {
+	return xxx_messageInfo_SetUserRoleResponse.Size(m)
+}
+func (m *SetUserRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetUserRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetUserRoleResponse proto.InternalMessageInfo
+
+type Organization struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt            int64    `protobuf:"varint,2,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Organization) Reset()         { *m = Organization{} }
+func (m *Organization) String() string { return proto.CompactTextString(m) }
+func (*Organization) ProtoMessage()    {}
+func (*Organization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{95}
+}
+
+func (m *Organization) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Organization.Unmarshal(m, b)
+}
+func (m *Organization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Organization.Marshal(b, m, deterministic)
+}
+func (m *Organization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Organization.Merge(m, src)
+}
+func (m *Organization) XXX_Size() int {
+	return xxx_messageInfo_Organization.Size(m)
+}
+func (m *Organization) XXX_DiscardUnknown() {
+	xxx_messageInfo_Organization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Organization proto.InternalMessageInfo
+
+func (m *Organization) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Organization) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+type CreateOrganizationRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	AdminUserName        string   `protobuf:"bytes,2,opt,name=adminUserName,proto3" json:"adminUserName,omitempty"`
+	AdminPassword        string   `protobuf:"bytes,3,opt,name=adminPassword,proto3" json:"adminPassword,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOrganizationRequest) Reset()         { *m = CreateOrganizationRequest{} }
+func (m *CreateOrganizationRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationRequest) ProtoMessage()    {}
+func (*CreateOrganizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{96}
+}
+
+func (m *CreateOrganizationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationRequest.Unmarshal(m, b)
+}
+func (m *CreateOrganizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateOrganizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationRequest.Merge(m, src)
+}
+func (m *CreateOrganizationRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationRequest.Size(m)
+}
+func (m *CreateOrganizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationRequest proto.InternalMessageInfo
+
+func (m *CreateOrganizationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CreateOrganizationRequest) GetAdminUserName() string {
+	if m != nil {
+		return m.AdminUserName
+	}
+	return ""
+}
+
+func (m *CreateOrganizationRequest) GetAdminPassword() string {
+	if m != nil {
+		return m.AdminPassword
+	}
+	return ""
+}
+
+type CreateOrganizationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateOrganizationResponse) Reset()         { *m = CreateOrganizationResponse{} }
+func (m *CreateOrganizationResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateOrganizationResponse) ProtoMessage()    {}
+func (*CreateOrganizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{97}
+}
+
+func (m *CreateOrganizationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateOrganizationResponse.Unmarshal(m, b)
+}
+func (m *CreateOrganizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateOrganizationResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateOrganizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateOrganizationResponse.Merge(m, src)
+}
+func (m *CreateOrganizationResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateOrganizationResponse.Size(m)
+}
+func (m *CreateOrganizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateOrganizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateOrganizationResponse proto.InternalMessageInfo
+
+type ListOrganizationsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListOrganizationsRequest) Reset()         { *m = ListOrganizationsRequest{} }
+func (m *ListOrganizationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationsRequest) ProtoMessage()    {}
+func (*ListOrganizationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{98}
+}
+
+func (m *ListOrganizationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrganizationsRequest.Unmarshal(m, b)
+}
+func (m *ListOrganizationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrganizationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListOrganizationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrganizationsRequest.Merge(m, src)
+}
+func (m *ListOrganizationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOrganizationsRequest.Size(m)
+}
+func (m *ListOrganizationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrganizationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrganizationsRequest proto.InternalMessageInfo
+
+type ListOrganizationsResponse struct {
+	Organization         []*Organization `protobuf:"bytes,1,rep,name=organization,proto3" json:"organization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListOrganizationsResponse) Reset()         { *m = ListOrganizationsResponse{} }
+func (m *ListOrganizationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrganizationsResponse) ProtoMessage()    {}
+func (*ListOrganizationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{99}
+}
+
+func (m *ListOrganizationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrganizationsResponse.Unmarshal(m, b)
+}
+func (m *ListOrganizationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrganizationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListOrganizationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrganizationsResponse.Merge(m, src)
+}
+func (m *ListOrganizationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOrganizationsResponse.Size(m)
+}
+func (m *ListOrganizationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrganizationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrganizationsResponse proto.InternalMessageInfo
+
+func (m *ListOrganizationsResponse) GetOrganization() []*Organization {
+	if m != nil {
+		return m.Organization
+	}
+	return nil
+}
+
+// Retention periods in seconds, 0 inherits the organization or deployment
+// setting and -1 keeps data forever.
+type RetentionPolicy struct {
+	LocationsSeconds     int64    `protobuf:"varint,1,opt,name=locationsSeconds,proto3" json:"locationsSeconds,omitempty"`
+	SessionsSeconds      int64    `protobuf:"varint,2,opt,name=sessionsSeconds,proto3" json:"sessionsSeconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetentionPolicy) Reset()         { *m = RetentionPolicy{} }
+func (m *RetentionPolicy) String() string { return proto.CompactTextString(m) }
+func (*RetentionPolicy) ProtoMessage()    {}
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{100}
+}
+
+func (m *RetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetentionPolicy.Unmarshal(m, b)
+}
+func (m *RetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetentionPolicy.Marshal(b, m, deterministic)
+}
+func (m *RetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetentionPolicy.Merge(m, src)
+}
+func (m *RetentionPolicy) XXX_Size() int {
+	return xxx_messageInfo_RetentionPolicy.Size(m)
+}
+func (m *RetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetentionPolicy proto.InternalMessageInfo
+
+func (m *RetentionPolicy) GetLocationsSeconds() int64 {
+	if m != nil {
+		return m.LocationsSeconds
+	}
+	return 0
+}
+
+func (m *RetentionPolicy) GetSessionsSeconds() int64 {
+	if m != nil {
+		return m.SessionsSeconds
+	}
+	return 0
+}
+
+// An empty userName refers to the organization.
+type GetRetentionPolicyRequest struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRetentionPolicyRequest) Reset()         { *m = GetRetentionPolicyRequest{} }
+func (m *GetRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRetentionPolicyRequest) ProtoMessage()    {}
+func (*GetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{101}
+}
+
+func (m *GetRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRetentionPolicyRequest.Unmarshal(m, b)
+}
+func (m *GetRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRetentionPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRetentionPolicyRequest.Merge(m, src)
+}
+func (m *GetRetentionPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRetentionPolicyRequest.Size(m)
+}
+func (m *GetRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *GetRetentionPolicyRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type SetRetentionPolicyRequest struct {
+	UserName             string           `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	Policy               *RetentionPolicy `protobuf:"bytes,2,opt,name=policy,proto3" json:"policy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *SetRetentionPolicyRequest) Reset()         { *m = SetRetentionPolicyRequest{} }
+func (m *SetRetentionPolicyRequest) String() string { return proto.CompactTextString(m) }
+func (*SetRetentionPolicyRequest) ProtoMessage()    {}
+func (*SetRetentionPolicyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{102}
+}
+
+func (m *SetRetentionPolicyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRetentionPolicyRequest.Unmarshal(m, b)
+}
+func (m *SetRetentionPolicyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRetentionPolicyRequest.Marshal(b, m, deterministic)
+}
+func (m *SetRetentionPolicyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRetentionPolicyRequest.Merge(m, src)
+}
+func (m *SetRetentionPolicyRequest) XXX_Size() int {
+	return xxx_messageInfo_SetRetentionPolicyRequest.Size(m)
+}
+func (m *SetRetentionPolicyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRetentionPolicyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRetentionPolicyRequest proto.InternalMessageInfo
+
+func (m *SetRetentionPolicyRequest) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+func (m *SetRetentionPolicyRequest) GetPolicy() *RetentionPolicy {
+	if m != nil {
+		return m.Policy
+	}
+	return nil
+}
+
+type SetRetentionPolicyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetRetentionPolicyResponse) Reset()         { *m = SetRetentionPolicyResponse{} }
+func (m *SetRetentionPolicyResponse) String() string { return proto.CompactTextString(m) }
+func (*SetRetentionPolicyResponse) ProtoMessage()    {}
+func (*SetRetentionPolicyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{103}
+}
+
+func (m *SetRetentionPolicyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetRetentionPolicyResponse.Unmarshal(m, b)
+}
+func (m *SetRetentionPolicyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetRetentionPolicyResponse.Marshal(b, m, deterministic)
+}
+func (m *SetRetentionPolicyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetRetentionPolicyResponse.Merge(m, src)
+}
+func (m *SetRetentionPolicyResponse) XXX_Size() int {
+	return xxx_messageInfo_SetRetentionPolicyResponse.Size(m)
+}
+func (m *SetRetentionPolicyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetRetentionPolicyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetRetentionPolicyResponse proto.InternalMessageInfo
+
+// Frames of the binary WebSocket protocol, "locationtracker.proto.v1". Each
+// frame carries one message, which wraps the same request or response as the
+// JSON protocol. Responses carry the requestId of the request they answer.
+type WebSocketRequest struct {
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// Types that are valid to be assigned to Request:
+	//	*WebSocketRequest_StartTracking
+	//	*WebSocketRequest_StopTracking
+	//	*WebSocketRequest_GetTrackables
+	//	*WebSocketRequest_Register
+	//	*WebSocketRequest_GetSessionIds
+	//	*WebSocketRequest_GetSessionData
+	//	*WebSocketRequest_Login
+	//	*WebSocketRequest_RefreshToken
+	//	*WebSocketRequest_RevokeToken
+	//	*WebSocketRequest_ChangePassword
+	//	*WebSocketRequest_Authenticate
+	//	*WebSocketRequest_CreateApiKey
+	//	*WebSocketRequest_ListApiKeys
+	//	*WebSocketRequest_LabelApiKey
+	//	*WebSocketRequest_RevokeApiKey
+	//	*WebSocketRequest_RequestTracking
+	//	*WebSocketRequest_ApproveTracking
+	//	*WebSocketRequest_DenyTracking
+	//	*WebSocketRequest_RevokeTracking
+	//	*WebSocketRequest_GetWatchers
+	//	*WebSocketRequest_CreateShareLink
+	//	*WebSocketRequest_ListShareLinks
+	//	*WebSocketRequest_RevokeShareLink
+	//	*WebSocketRequest_CreateGroup
+	//	*WebSocketRequest_DeleteGroup
+	//	*WebSocketRequest_ListGroups
+	//	*WebSocketRequest_AddGroupMember
+	//	*WebSocketRequest_RemoveGroupMember
+	//	*WebSocketRequest_StartGroupTracking
+	//	*WebSocketRequest_DeleteAccount
+	//	*WebSocketRequest_ExportMyData
+	//	*WebSocketRequest_CreatePrivacyZone
+	//	*WebSocketRequest_ListPrivacyZones
+	//	*WebSocketRequest_DeletePrivacyZone
+	//	*WebSocketRequest_SetTrackingPrecision
+	//	*WebSocketRequest_PauseSharing
+	//	*WebSocketRequest_ResumeSharing
+	//	*WebSocketRequest_GetAuditLog
+	//	*WebSocketRequest_ReportLocation
+	//	*WebSocketRequest_StartSession
+	//	*WebSocketRequest_StopSession
+	Request              isWebSocketRequest_Request `protobuf_oneof:"request"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *WebSocketRequest) Reset()         { *m = WebSocketRequest{} }
+func (m *WebSocketRequest) String() string { return proto.CompactTextString(m) }
+func (*WebSocketRequest) ProtoMessage()    {}
+func (*WebSocketRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{104}
+}
+
+func (m *WebSocketRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebSocketRequest.Unmarshal(m, b)
+}
+func (m *WebSocketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebSocketRequest.Marshal(b, m, deterministic)
+}
+func (m *WebSocketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketRequest.Merge(m, src)
+}
+func (m *WebSocketRequest) XXX_Size() int {
+	return xxx_messageInfo_WebSocketRequest.Size(m)
+}
+func (m *WebSocketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebSocketRequest proto.InternalMessageInfo
+
+func (m *WebSocketRequest) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type isWebSocketRequest_Request interface {
+	isWebSocketRequest_Request()
+}
+
+type WebSocketRequest_StartTracking struct {
+	StartTracking *StartTrackingRequest `protobuf:"bytes,2,opt,name=startTracking,proto3,oneof"`
+}
+
+type WebSocketRequest_StopTracking struct {
+	StopTracking *StopTrackingRequest `protobuf:"bytes,3,opt,name=stopTracking,proto3,oneof"`
+}
+
+type WebSocketRequest_GetTrackables struct {
+	GetTrackables *GetTrackablesRequest `protobuf:"bytes,4,opt,name=getTrackables,proto3,oneof"`
+}
+
+type WebSocketRequest_Register struct {
+	Register *RegisterRequest `protobuf:"bytes,5,opt,name=register,proto3,oneof"`
+}
+
+type WebSocketRequest_GetSessionIds struct {
+	GetSessionIds *SessionIdsRequest `protobuf:"bytes,6,opt,name=getSessionIds,proto3,oneof"`
+}
+
+type WebSocketRequest_GetSessionData struct {
+	GetSessionData *SessionDataRequest `protobuf:"bytes,7,opt,name=getSessionData,proto3,oneof"`
+}
+
+type WebSocketRequest_Login struct {
+	Login *LoginRequest `protobuf:"bytes,8,opt,name=login,proto3,oneof"`
+}
+
+type WebSocketRequest_RefreshToken struct {
+	RefreshToken *RefreshTokenRequest `protobuf:"bytes,9,opt,name=refreshToken,proto3,oneof"`
+}
+
+type WebSocketRequest_RevokeToken struct {
+	RevokeToken *RevokeTokenRequest `protobuf:"bytes,10,opt,name=revokeToken,proto3,oneof"`
+}
+
+type WebSocketRequest_ChangePassword struct {
+	ChangePassword *ChangePasswordRequest `protobuf:"bytes,11,opt,name=changePassword,proto3,oneof"`
+}
+
+type WebSocketRequest_Authenticate struct {
+	Authenticate *WebSocketRequest_AuthenticateRequest `protobuf:"bytes,12,opt,name=authenticate,proto3,oneof"`
+}
+
+type WebSocketRequest_CreateApiKey struct {
+	CreateApiKey *CreateApiKeyRequest `protobuf:"bytes,13,opt,name=createApiKey,proto3,oneof"`
+}
+
+type WebSocketRequest_ListApiKeys struct {
+	ListApiKeys *ListApiKeysRequest `protobuf:"bytes,14,opt,name=listApiKeys,proto3,oneof"`
+}
+
+type WebSocketRequest_LabelApiKey struct {
+	LabelApiKey *LabelApiKeyRequest `protobuf:"bytes,15,opt,name=labelApiKey,proto3,oneof"`
+}
+
+type WebSocketRequest_RevokeApiKey struct {
+	RevokeApiKey *RevokeApiKeyRequest `protobuf:"bytes,16,opt,name=revokeApiKey,proto3,oneof"`
+}
+
+type WebSocketRequest_RequestTracking struct {
+	RequestTracking *RequestTrackingRequest `protobuf:"bytes,17,opt,name=requestTracking,proto3,oneof"`
+}
+
+type WebSocketRequest_ApproveTracking struct {
+	ApproveTracking *ApproveTrackingRequest `protobuf:"bytes,18,opt,name=approveTracking,proto3,oneof"`
+}
+
+type WebSocketRequest_DenyTracking struct {
+	DenyTracking *DenyTrackingRequest `protobuf:"bytes,19,opt,name=denyTracking,proto3,oneof"`
+}
+
+type WebSocketRequest_RevokeTracking struct {
+	RevokeTracking *RevokeTrackingRequest `protobuf:"bytes,20,opt,name=revokeTracking,proto3,oneof"`
+}
+
+type WebSocketRequest_GetWatchers struct {
+	GetWatchers *GetWatchersRequest `protobuf:"bytes,21,opt,name=getWatchers,proto3,oneof"`
+}
+
+type WebSocketRequest_CreateShareLink struct {
+	CreateShareLink *CreateShareLinkRequest `protobuf:"bytes,22,opt,name=createShareLink,proto3,oneof"`
+}
+
+type WebSocketRequest_ListShareLinks struct {
+	ListShareLinks *ListShareLinksRequest `protobuf:"bytes,23,opt,name=listShareLinks,proto3,oneof"`
+}
+
+type WebSocketRequest_RevokeShareLink struct {
+	RevokeShareLink *RevokeShareLinkRequest `protobuf:"bytes,24,opt,name=revokeShareLink,proto3,oneof"`
+}
+
+type WebSocketRequest_CreateGroup struct {
+	CreateGroup *CreateGroupRequest `protobuf:"bytes,25,opt,name=createGroup,proto3,oneof"`
+}
+
+type WebSocketRequest_DeleteGroup struct {
+	DeleteGroup *DeleteGroupRequest `protobuf:"bytes,26,opt,name=deleteGroup,proto3,oneof"`
+}
+
+type WebSocketRequest_ListGroups struct {
+	ListGroups *ListGroupsRequest `protobuf:"bytes,27,opt,name=listGroups,proto3,oneof"`
+}
+
+type WebSocketRequest_AddGroupMember struct {
+	AddGroupMember *AddGroupMemberRequest `protobuf:"bytes,28,opt,name=addGroupMember,proto3,oneof"`
+}
+
+type WebSocketRequest_RemoveGroupMember struct {
+	RemoveGroupMember *RemoveGroupMemberRequest `protobuf:"bytes,29,opt,name=removeGroupMember,proto3,oneof"`
+}
+
+type WebSocketRequest_StartGroupTracking struct {
+	StartGroupTracking *StartGroupTrackingRequest `protobuf:"bytes,30,opt,name=startGroupTracking,proto3,oneof"`
+}
+
+type WebSocketRequest_DeleteAccount struct {
+	DeleteAccount *DeleteAccountRequest `protobuf:"bytes,31,opt,name=deleteAccount,proto3,oneof"`
+}
+
+type WebSocketRequest_ExportMyData struct {
+	ExportMyData *ExportMyDataRequest `protobuf:"bytes,32,opt,name=exportMyData,proto3,oneof"`
+}
+
+type WebSocketRequest_CreatePrivacyZone struct {
+	CreatePrivacyZone *CreatePrivacyZoneRequest `protobuf:"bytes,33,opt,name=createPrivacyZone,proto3,oneof"`
+}
+
+type WebSocketRequest_ListPrivacyZones struct {
+	ListPrivacyZones *ListPrivacyZonesRequest `protobuf:"bytes,34,opt,name=listPrivacyZones,proto3,oneof"`
+}
+
+type WebSocketRequest_DeletePrivacyZone struct {
+	DeletePrivacyZone *DeletePrivacyZoneRequest `protobuf:"bytes,35,opt,name=deletePrivacyZone,proto3,oneof"`
+}
+
+type WebSocketRequest_SetTrackingPrecision struct {
+	SetTrackingPrecision *SetTrackingPrecisionRequest `protobuf:"bytes,36,opt,name=setTrackingPrecision,proto3,oneof"`
+}
+
+type WebSocketRequest_PauseSharing struct {
+	PauseSharing *PauseSharingRequest `protobuf:"bytes,37,opt,name=pauseSharing,proto3,oneof"`
+}
+
+type WebSocketRequest_ResumeSharing struct {
+	ResumeSharing *ResumeSharingRequest `protobuf:"bytes,38,opt,name=resumeSharing,proto3,oneof"`
+}
+
+type WebSocketRequest_GetAuditLog struct {
+	GetAuditLog *GetAuditLogRequest `protobuf:"bytes,39,opt,name=getAuditLog,proto3,oneof"`
+}
+
+type WebSocketRequest_ReportLocation struct {
+	ReportLocation *WebSocketRequest_ReportLocationRequest `protobuf:"bytes,40,opt,name=reportLocation,proto3,oneof"`
+}
+
+type WebSocketRequest_StartSession struct {
+	StartSession *StartSessionRequest `protobuf:"bytes,41,opt,name=startSession,proto3,oneof"`
+}
+
+type WebSocketRequest_StopSession struct {
+	StopSession *StopSessionRequest `protobuf:"bytes,42,opt,name=stopSession,proto3,oneof"`
+}
+
+func (*WebSocketRequest_StartTracking) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_StopTracking) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_GetTrackables) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_Register) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_GetSessionIds) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_GetSessionData) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_Login) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_RefreshToken) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_RevokeToken) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ChangePassword) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_Authenticate) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_CreateApiKey) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ListApiKeys) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_LabelApiKey) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_RevokeApiKey) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_RequestTracking) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ApproveTracking) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_DenyTracking) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_RevokeTracking) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_GetWatchers) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_CreateShareLink) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ListShareLinks) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_RevokeShareLink) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_CreateGroup) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_DeleteGroup) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ListGroups) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_AddGroupMember) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_RemoveGroupMember) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_StartGroupTracking) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_DeleteAccount) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ExportMyData) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_CreatePrivacyZone) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ListPrivacyZones) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_DeletePrivacyZone) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_SetTrackingPrecision) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_PauseSharing) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ResumeSharing) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_GetAuditLog) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_ReportLocation) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_StartSession) isWebSocketRequest_Request() {}
+
+func (*WebSocketRequest_StopSession) isWebSocketRequest_Request() {}
+
+func (m *WebSocketRequest) GetRequest() isWebSocketRequest_Request {
+	if m != nil {
+		return m.Request
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetStartTracking() *StartTrackingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_StartTracking); ok {
+		return x.StartTracking
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetStopTracking() *StopTrackingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_StopTracking); ok {
+		return x.StopTracking
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetGetTrackables() *GetTrackablesRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_GetTrackables); ok {
+		return x.GetTrackables
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetRegister() *RegisterRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_Register); ok {
+		return x.Register
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetGetSessionIds() *SessionIdsRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_GetSessionIds); ok {
+		return x.GetSessionIds
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetGetSessionData() *SessionDataRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_GetSessionData); ok {
+		return x.GetSessionData
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetLogin() *LoginRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_Login); ok {
+		return x.Login
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetRefreshToken() *RefreshTokenRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_RefreshToken); ok {
+		return x.RefreshToken
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetRevokeToken() *RevokeTokenRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_RevokeToken); ok {
+		return x.RevokeToken
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetChangePassword() *ChangePasswordRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ChangePassword); ok {
+		return x.ChangePassword
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetAuthenticate() *WebSocketRequest_AuthenticateRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_Authenticate); ok {
+		return x.Authenticate
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetCreateApiKey() *CreateApiKeyRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_CreateApiKey); ok {
+		return x.CreateApiKey
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetListApiKeys() *ListApiKeysRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ListApiKeys); ok {
+		return x.ListApiKeys
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetLabelApiKey() *LabelApiKeyRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_LabelApiKey); ok {
+		return x.LabelApiKey
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetRevokeApiKey() *RevokeApiKeyRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_RevokeApiKey); ok {
+		return x.RevokeApiKey
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetRequestTracking() *RequestTrackingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_RequestTracking); ok {
+		return x.RequestTracking
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetApproveTracking() *ApproveTrackingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ApproveTracking); ok {
+		return x.ApproveTracking
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetDenyTracking() *DenyTrackingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_DenyTracking); ok {
+		return x.DenyTracking
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetRevokeTracking() *RevokeTrackingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_RevokeTracking); ok {
+		return x.RevokeTracking
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetGetWatchers() *GetWatchersRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_GetWatchers); ok {
+		return x.GetWatchers
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetCreateShareLink() *CreateShareLinkRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_CreateShareLink); ok {
+		return x.CreateShareLink
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetListShareLinks() *ListShareLinksRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ListShareLinks); ok {
+		return x.ListShareLinks
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetRevokeShareLink() *RevokeShareLinkRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_RevokeShareLink); ok {
+		return x.RevokeShareLink
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetCreateGroup() *CreateGroupRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_CreateGroup); ok {
+		return x.CreateGroup
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetDeleteGroup() *DeleteGroupRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_DeleteGroup); ok {
+		return x.DeleteGroup
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetListGroups() *ListGroupsRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ListGroups); ok {
+		return x.ListGroups
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetAddGroupMember() *AddGroupMemberRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_AddGroupMember); ok {
+		return x.AddGroupMember
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetRemoveGroupMember() *RemoveGroupMemberRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_RemoveGroupMember); ok {
+		return x.RemoveGroupMember
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetStartGroupTracking() *StartGroupTrackingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_StartGroupTracking); ok {
+		return x.StartGroupTracking
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetDeleteAccount() *DeleteAccountRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_DeleteAccount); ok {
+		return x.DeleteAccount
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetExportMyData() *ExportMyDataRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ExportMyData); ok {
+		return x.ExportMyData
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetCreatePrivacyZone() *CreatePrivacyZoneRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_CreatePrivacyZone); ok {
+		return x.CreatePrivacyZone
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetListPrivacyZones() *ListPrivacyZonesRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ListPrivacyZones); ok {
+		return x.ListPrivacyZones
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetDeletePrivacyZone() *DeletePrivacyZoneRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_DeletePrivacyZone); ok {
+		return x.DeletePrivacyZone
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetSetTrackingPrecision() *SetTrackingPrecisionRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_SetTrackingPrecision); ok {
+		return x.SetTrackingPrecision
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetPauseSharing() *PauseSharingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_PauseSharing); ok {
+		return x.PauseSharing
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetResumeSharing() *ResumeSharingRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ResumeSharing); ok {
+		return x.ResumeSharing
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetGetAuditLog() *GetAuditLogRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_GetAuditLog); ok {
+		return x.GetAuditLog
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetReportLocation() *WebSocketRequest_ReportLocationRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_ReportLocation); ok {
+		return x.ReportLocation
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetStartSession() *StartSessionRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_StartSession); ok {
+		return x.StartSession
+	}
+	return nil
+}
+
+func (m *WebSocketRequest) GetStopSession() *StopSessionRequest {
+	if x, ok := m.GetRequest().(*WebSocketRequest_StopSession); ok {
+		return x.StopSession
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WebSocketRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WebSocketRequest_StartTracking)(nil),
+		(*WebSocketRequest_StopTracking)(nil),
+		(*WebSocketRequest_GetTrackables)(nil),
+		(*WebSocketRequest_Register)(nil),
+		(*WebSocketRequest_GetSessionIds)(nil),
+		(*WebSocketRequest_GetSessionData)(nil),
+		(*WebSocketRequest_Login)(nil),
+		(*WebSocketRequest_RefreshToken)(nil),
+		(*WebSocketRequest_RevokeToken)(nil),
+		(*WebSocketRequest_ChangePassword)(nil),
+		(*WebSocketRequest_Authenticate)(nil),
+		(*WebSocketRequest_CreateApiKey)(nil),
+		(*WebSocketRequest_ListApiKeys)(nil),
+		(*WebSocketRequest_LabelApiKey)(nil),
+		(*WebSocketRequest_RevokeApiKey)(nil),
+		(*WebSocketRequest_RequestTracking)(nil),
+		(*WebSocketRequest_ApproveTracking)(nil),
+		(*WebSocketRequest_DenyTracking)(nil),
+		(*WebSocketRequest_RevokeTracking)(nil),
+		(*WebSocketRequest_GetWatchers)(nil),
+		(*WebSocketRequest_CreateShareLink)(nil),
+		(*WebSocketRequest_ListShareLinks)(nil),
+		(*WebSocketRequest_RevokeShareLink)(nil),
+		(*WebSocketRequest_CreateGroup)(nil),
+		(*WebSocketRequest_DeleteGroup)(nil),
+		(*WebSocketRequest_ListGroups)(nil),
+		(*WebSocketRequest_AddGroupMember)(nil),
+		(*WebSocketRequest_RemoveGroupMember)(nil),
+		(*WebSocketRequest_StartGroupTracking)(nil),
+		(*WebSocketRequest_DeleteAccount)(nil),
+		(*WebSocketRequest_ExportMyData)(nil),
+		(*WebSocketRequest_CreatePrivacyZone)(nil),
+		(*WebSocketRequest_ListPrivacyZones)(nil),
+		(*WebSocketRequest_DeletePrivacyZone)(nil),
+		(*WebSocketRequest_SetTrackingPrecision)(nil),
+		(*WebSocketRequest_PauseSharing)(nil),
+		(*WebSocketRequest_ResumeSharing)(nil),
+		(*WebSocketRequest_GetAuditLog)(nil),
+		(*WebSocketRequest_ReportLocation)(nil),
+		(*WebSocketRequest_StartSession)(nil),
+		(*WebSocketRequest_StopSession)(nil),
+	}
+}
+
+// Authenticates the connection with an access token or API key.
+type WebSocketRequest_AuthenticateRequest struct {
+	Token                string   `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebSocketRequest_AuthenticateRequest) Reset()         { *m = WebSocketRequest_AuthenticateRequest{} }
+func (m *WebSocketRequest_AuthenticateRequest) String() string { return proto.CompactTextString(m) }
+func (*WebSocketRequest_AuthenticateRequest) ProtoMessage()    {}
+func (*WebSocketRequest_AuthenticateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{104, 0}
+}
+
+func (m *WebSocketRequest_AuthenticateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebSocketRequest_AuthenticateRequest.Unmarshal(m, b)
+}
+func (m *WebSocketRequest_AuthenticateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebSocketRequest_AuthenticateRequest.Marshal(b, m, deterministic)
+}
+func (m *WebSocketRequest_AuthenticateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketRequest_AuthenticateRequest.Merge(m, src)
+}
+func (m *WebSocketRequest_AuthenticateRequest) XXX_Size() int {
+	return xxx_messageInfo_WebSocketRequest_AuthenticateRequest.Size(m)
+}
+func (m *WebSocketRequest_AuthenticateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketRequest_AuthenticateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebSocketRequest_AuthenticateRequest proto.InternalMessageInfo
+
+func (m *WebSocketRequest_AuthenticateRequest) GetToken() string {
+	if m != nil {
+		return m.Token
+	}
+	return ""
+}
+
+// Reports locations of trackeeName, the trackeeName of each point is
+// ignored.
+type WebSocketRequest_ReportLocationRequest struct {
+	TrackeeName          string          `protobuf:"bytes,1,opt,name=trackeeName,proto3" json:"trackeeName,omitempty"`
+	TrackingData         []*TrackingData `protobuf:"bytes,2,rep,name=trackingData,proto3" json:"trackingData,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *WebSocketRequest_ReportLocationRequest) Reset() {
+	*m = WebSocketRequest_ReportLocationRequest{}
+}
+func (m *WebSocketRequest_ReportLocationRequest) String() string { return proto.CompactTextString(m) }
+func (*WebSocketRequest_ReportLocationRequest) ProtoMessage()    {}
+func (*WebSocketRequest_ReportLocationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{104, 1}
+}
+
+func (m *WebSocketRequest_ReportLocationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebSocketRequest_ReportLocationRequest.Unmarshal(m, b)
+}
+func (m *WebSocketRequest_ReportLocationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebSocketRequest_ReportLocationRequest.Marshal(b, m, deterministic)
+}
+func (m *WebSocketRequest_ReportLocationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketRequest_ReportLocationRequest.Merge(m, src)
+}
+func (m *WebSocketRequest_ReportLocationRequest) XXX_Size() int {
+	return xxx_messageInfo_WebSocketRequest_ReportLocationRequest.Size(m)
+}
+func (m *WebSocketRequest_ReportLocationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketRequest_ReportLocationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebSocketRequest_ReportLocationRequest proto.InternalMessageInfo
+
+func (m *WebSocketRequest_ReportLocationRequest) GetTrackeeName() string {
+	if m != nil {
+		return m.TrackeeName
+	}
+	return ""
+}
+
+func (m *WebSocketRequest_ReportLocationRequest) GetTrackingData() []*TrackingData {
+	if m != nil {
+		return m.TrackingData
+	}
+	return nil
+}
+
+// Status changes of a trackee are sent as trackingData with a status set, as
+// on the gRPC streams.
+type WebSocketResponse struct {
+	RequestId string `protobuf:"bytes,1,opt,name=requestId,proto3" json:"requestId,omitempty"`
+	// Types that are valid to be assigned to Response:
+	//	*WebSocketResponse_Trackables
+	//	*WebSocketResponse_Registered
+	//	*WebSocketResponse_SessionIds
+	//	*WebSocketResponse_SessionData
+	//	*WebSocketResponse_TrackingData
+	//	*WebSocketResponse_Tokens
+	//	*WebSocketResponse_Authenticated
+	//	*WebSocketResponse_ApiKey
+	//	*WebSocketResponse_ApiKeys
+	//	*WebSocketResponse_Watchers
+	//	*WebSocketResponse_ShareLink
+	//	*WebSocketResponse_ShareLinks
+	//	*WebSocketResponse_Group
+	//	*WebSocketResponse_Groups
+	//	*WebSocketResponse_Export
+	//	*WebSocketResponse_PrivacyZone
+	//	*WebSocketResponse_PrivacyZones
+	//	*WebSocketResponse_AuditLog
+	//	*WebSocketResponse_Ok
+	//	*WebSocketResponse_Error
+	//	*WebSocketResponse_Session
+	//	*WebSocketResponse_LocationsReported
+	Response             isWebSocketResponse_Response `protobuf_oneof:"response"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *WebSocketResponse) Reset()         { *m = WebSocketResponse{} }
+func (m *WebSocketResponse) String() string { return proto.CompactTextString(m) }
+func (*WebSocketResponse) ProtoMessage()    {}
+func (*WebSocketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{105}
+}
+
+func (m *WebSocketResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebSocketResponse.Unmarshal(m, b)
+}
+func (m *WebSocketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebSocketResponse.Marshal(b, m, deterministic)
+}
+func (m *WebSocketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketResponse.Merge(m, src)
+}
+func (m *WebSocketResponse) XXX_Size() int {
+	return xxx_messageInfo_WebSocketResponse.Size(m)
+}
+func (m *WebSocketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebSocketResponse proto.InternalMessageInfo
+
+func (m *WebSocketResponse) GetRequestId() string {
+	if m != nil {
+		return m.RequestId
+	}
+	return ""
+}
+
+type isWebSocketResponse_Response interface {
+	isWebSocketResponse_Response()
+}
+
+type WebSocketResponse_Trackables struct {
+	Trackables *GetTrackablesResponse `protobuf:"bytes,2,opt,name=trackables,proto3,oneof"`
+}
+
+type WebSocketResponse_Registered struct {
+	Registered *RegisterResponse `protobuf:"bytes,3,opt,name=registered,proto3,oneof"`
+}
+
+type WebSocketResponse_SessionIds struct {
+	SessionIds *SessionIdsResponse `protobuf:"bytes,4,opt,name=sessionIds,proto3,oneof"`
+}
+
+type WebSocketResponse_SessionData struct {
+	SessionData *SessionDataResponse `protobuf:"bytes,5,opt,name=sessionData,proto3,oneof"`
+}
+
+type WebSocketResponse_TrackingData struct {
+	TrackingData *TrackingData `protobuf:"bytes,6,opt,name=trackingData,proto3,oneof"`
+}
+
+type WebSocketResponse_Tokens struct {
+	Tokens *LoginResponse `protobuf:"bytes,7,opt,name=tokens,proto3,oneof"`
+}
+
+type WebSocketResponse_Authenticated struct {
+	Authenticated *WebSocketResponse_AuthenticatedResponse `protobuf:"bytes,8,opt,name=authenticated,proto3,oneof"`
+}
+
+type WebSocketResponse_ApiKey struct {
+	ApiKey *CreateApiKeyResponse `protobuf:"bytes,9,opt,name=apiKey,proto3,oneof"`
+}
+
+type WebSocketResponse_ApiKeys struct {
+	ApiKeys *ListApiKeysResponse `protobuf:"bytes,10,opt,name=apiKeys,proto3,oneof"`
+}
+
+type WebSocketResponse_Watchers struct {
+	Watchers *GetWatchersResponse `protobuf:"bytes,11,opt,name=watchers,proto3,oneof"`
+}
+
+type WebSocketResponse_ShareLink struct {
+	ShareLink *CreateShareLinkResponse `protobuf:"bytes,12,opt,name=shareLink,proto3,oneof"`
+}
+
+type WebSocketResponse_ShareLinks struct {
+	ShareLinks *ListShareLinksResponse `protobuf:"bytes,13,opt,name=shareLinks,proto3,oneof"`
+}
+
+type WebSocketResponse_Group struct {
+	Group *CreateGroupResponse `protobuf:"bytes,14,opt,name=group,proto3,oneof"`
+}
+
+type WebSocketResponse_Groups struct {
+	Groups *ListGroupsResponse `protobuf:"bytes,15,opt,name=groups,proto3,oneof"`
+}
+
+type WebSocketResponse_Export struct {
+	Export *ExportMyDataResponse `protobuf:"bytes,16,opt,name=export,proto3,oneof"`
+}
+
+type WebSocketResponse_PrivacyZone struct {
+	PrivacyZone *CreatePrivacyZoneResponse `protobuf:"bytes,17,opt,name=privacyZone,proto3,oneof"`
+}
+
+type WebSocketResponse_PrivacyZones struct {
+	PrivacyZones *ListPrivacyZonesResponse `protobuf:"bytes,18,opt,name=privacyZones,proto3,oneof"`
+}
+
+type WebSocketResponse_AuditLog struct {
+	AuditLog *GetAuditLogResponse `protobuf:"bytes,19,opt,name=auditLog,proto3,oneof"`
+}
+
+type WebSocketResponse_Ok struct {
+	Ok *WebSocketResponse_OkResponse `protobuf:"bytes,20,opt,name=ok,proto3,oneof"`
+}
+
+type WebSocketResponse_Error struct {
+	Error *WebSocketResponse_ErrorResponse `protobuf:"bytes,21,opt,name=error,proto3,oneof"`
+}
+
+type WebSocketResponse_Session struct {
+	Session *StartSessionResponse `protobuf:"bytes,22,opt,name=session,proto3,oneof"`
+}
+
+type WebSocketResponse_LocationsReported struct {
+	LocationsReported *WebSocketResponse_LocationsReportedResponse `protobuf:"bytes,23,opt,name=locationsReported,proto3,oneof"`
+}
+
+func (*WebSocketResponse_Trackables) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Registered) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_SessionIds) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_SessionData) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_TrackingData) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Tokens) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Authenticated) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_ApiKey) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_ApiKeys) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Watchers) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_ShareLink) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_ShareLinks) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Group) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Groups) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Export) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_PrivacyZone) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_PrivacyZones) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_AuditLog) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Ok) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Error) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_Session) isWebSocketResponse_Response() {}
+
+func (*WebSocketResponse_LocationsReported) isWebSocketResponse_Response() {}
+
+func (m *WebSocketResponse) GetResponse() isWebSocketResponse_Response {
+	if m != nil {
+		return m.Response
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetTrackables() *GetTrackablesResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Trackables); ok {
+		return x.Trackables
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetRegistered() *RegisterResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Registered); ok {
+		return x.Registered
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetSessionIds() *SessionIdsResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_SessionIds); ok {
+		return x.SessionIds
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetSessionData() *SessionDataResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_SessionData); ok {
+		return x.SessionData
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetTrackingData() *TrackingData {
+	if x, ok := m.GetResponse().(*WebSocketResponse_TrackingData); ok {
+		return x.TrackingData
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetTokens() *LoginResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Tokens); ok {
+		return x.Tokens
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetAuthenticated() *WebSocketResponse_AuthenticatedResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Authenticated); ok {
+		return x.Authenticated
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetApiKey() *CreateApiKeyResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_ApiKey); ok {
+		return x.ApiKey
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetApiKeys() *ListApiKeysResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_ApiKeys); ok {
+		return x.ApiKeys
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetWatchers() *GetWatchersResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Watchers); ok {
+		return x.Watchers
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetShareLink() *CreateShareLinkResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_ShareLink); ok {
+		return x.ShareLink
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetShareLinks() *ListShareLinksResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_ShareLinks); ok {
+		return x.ShareLinks
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetGroup() *CreateGroupResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Group); ok {
+		return x.Group
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetGroups() *ListGroupsResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Groups); ok {
+		return x.Groups
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetExport() *ExportMyDataResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Export); ok {
+		return x.Export
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetPrivacyZone() *CreatePrivacyZoneResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_PrivacyZone); ok {
+		return x.PrivacyZone
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetPrivacyZones() *ListPrivacyZonesResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_PrivacyZones); ok {
+		return x.PrivacyZones
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetAuditLog() *GetAuditLogResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_AuditLog); ok {
+		return x.AuditLog
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetOk() *WebSocketResponse_OkResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Ok); ok {
+		return x.Ok
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetError() *WebSocketResponse_ErrorResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Error); ok {
+		return x.Error
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetSession() *StartSessionResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_Session); ok {
+		return x.Session
+	}
+	return nil
+}
+
+func (m *WebSocketResponse) GetLocationsReported() *WebSocketResponse_LocationsReportedResponse {
+	if x, ok := m.GetResponse().(*WebSocketResponse_LocationsReported); ok {
+		return x.LocationsReported
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WebSocketResponse) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WebSocketResponse_Trackables)(nil),
+		(*WebSocketResponse_Registered)(nil),
+		(*WebSocketResponse_SessionIds)(nil),
+		(*WebSocketResponse_SessionData)(nil),
+		(*WebSocketResponse_TrackingData)(nil),
+		(*WebSocketResponse_Tokens)(nil),
+		(*WebSocketResponse_Authenticated)(nil),
+		(*WebSocketResponse_ApiKey)(nil),
+		(*WebSocketResponse_ApiKeys)(nil),
+		(*WebSocketResponse_Watchers)(nil),
+		(*WebSocketResponse_ShareLink)(nil),
+		(*WebSocketResponse_ShareLinks)(nil),
+		(*WebSocketResponse_Group)(nil),
+		(*WebSocketResponse_Groups)(nil),
+		(*WebSocketResponse_Export)(nil),
+		(*WebSocketResponse_PrivacyZone)(nil),
+		(*WebSocketResponse_PrivacyZones)(nil),
+		(*WebSocketResponse_AuditLog)(nil),
+		(*WebSocketResponse_Ok)(nil),
+		(*WebSocketResponse_Error)(nil),
+		(*WebSocketResponse_Session)(nil),
+		(*WebSocketResponse_LocationsReported)(nil),
+	}
+}
+
+type WebSocketResponse_AuthenticatedResponse struct {
+	UserName             string   `protobuf:"bytes,1,opt,name=userName,proto3" json:"userName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebSocketResponse_AuthenticatedResponse) Reset() {
+	*m = WebSocketResponse_AuthenticatedResponse{}
+}
+func (m *WebSocketResponse_AuthenticatedResponse) String() string { return proto.CompactTextString(m) }
+func (*WebSocketResponse_AuthenticatedResponse) ProtoMessage()    {}
+func (*WebSocketResponse_AuthenticatedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{105, 0}
+}
+
+func (m *WebSocketResponse_AuthenticatedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebSocketResponse_AuthenticatedResponse.Unmarshal(m, b)
+}
+func (m *WebSocketResponse_AuthenticatedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebSocketResponse_AuthenticatedResponse.Marshal(b, m, deterministic)
+}
+func (m *WebSocketResponse_AuthenticatedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketResponse_AuthenticatedResponse.Merge(m, src)
+}
+func (m *WebSocketResponse_AuthenticatedResponse) XXX_Size() int {
+	return xxx_messageInfo_WebSocketResponse_AuthenticatedResponse.Size(m)
+}
+func (m *WebSocketResponse_AuthenticatedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketResponse_AuthenticatedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebSocketResponse_AuthenticatedResponse proto.InternalMessageInfo
+
+func (m *WebSocketResponse_AuthenticatedResponse) GetUserName() string {
+	if m != nil {
+		return m.UserName
+	}
+	return ""
+}
+
+type WebSocketResponse_OkResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebSocketResponse_OkResponse) Reset()         { *m = WebSocketResponse_OkResponse{} }
+func (m *WebSocketResponse_OkResponse) String() string { return proto.CompactTextString(m) }
+func (*WebSocketResponse_OkResponse) ProtoMessage()    {}
+func (*WebSocketResponse_OkResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{105, 1}
+}
+
+func (m *WebSocketResponse_OkResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebSocketResponse_OkResponse.Unmarshal(m, b)
+}
+func (m *WebSocketResponse_OkResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebSocketResponse_OkResponse.Marshal(b, m, deterministic)
+}
+func (m *WebSocketResponse_OkResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketResponse_OkResponse.Merge(m, src)
+}
+func (m *WebSocketResponse_OkResponse) XXX_Size() int {
+	return xxx_messageInfo_WebSocketResponse_OkResponse.Size(m)
+}
+func (m *WebSocketResponse_OkResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketResponse_OkResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebSocketResponse_OkResponse proto.InternalMessageInfo
+
+// code is named after the gRPC status code, field names the invalid
+// field of the request if there is one. count is the number of points
+// stored by a location report that failed part way.
+type WebSocketResponse_ErrorResponse struct {
+	Code                 string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	Message              string   `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Field                string   `protobuf:"bytes,3,opt,name=field,proto3" json:"field,omitempty"`
+	Count                int32    `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebSocketResponse_ErrorResponse) Reset()         { *m = WebSocketResponse_ErrorResponse{} }
+func (m *WebSocketResponse_ErrorResponse) String() string { return proto.CompactTextString(m) }
+func (*WebSocketResponse_ErrorResponse) ProtoMessage()    {}
+func (*WebSocketResponse_ErrorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{105, 2}
+}
+
+func (m *WebSocketResponse_ErrorResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebSocketResponse_ErrorResponse.Unmarshal(m, b)
+}
+func (m *WebSocketResponse_ErrorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebSocketResponse_ErrorResponse.Marshal(b, m, deterministic)
+}
+func (m *WebSocketResponse_ErrorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketResponse_ErrorResponse.Merge(m, src)
+}
+func (m *WebSocketResponse_ErrorResponse) XXX_Size() int {
+	return xxx_messageInfo_WebSocketResponse_ErrorResponse.Size(m)
+}
+func (m *WebSocketResponse_ErrorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketResponse_ErrorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebSocketResponse_ErrorResponse proto.InternalMessageInfo
+
+func (m *WebSocketResponse_ErrorResponse) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *WebSocketResponse_ErrorResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *WebSocketResponse_ErrorResponse) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *WebSocketResponse_ErrorResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type WebSocketResponse_LocationsReportedResponse struct {
+	Count                int32    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WebSocketResponse_LocationsReportedResponse) Reset() {
+	*m = WebSocketResponse_LocationsReportedResponse{}
+}
+func (m *WebSocketResponse_LocationsReportedResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*WebSocketResponse_LocationsReportedResponse) ProtoMessage() {}
+func (*WebSocketResponse_LocationsReportedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c19e669b665ab3c, []int{105, 3}
+}
+
+func (m *WebSocketResponse_LocationsReportedResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebSocketResponse_LocationsReportedResponse.Unmarshal(m, b)
+}
+func (m *WebSocketResponse_LocationsReportedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebSocketResponse_LocationsReportedResponse.Marshal(b, m, deterministic)
+}
+func (m *WebSocketResponse_LocationsReportedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebSocketResponse_LocationsReportedResponse.Merge(m, src)
+}
+func (m *WebSocketResponse_LocationsReportedResponse) XXX_Size() int {
+	return xxx_messageInfo_WebSocketResponse_LocationsReportedResponse.Size(m)
+}
+func (m *WebSocketResponse_LocationsReportedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebSocketResponse_LocationsReportedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WebSocketResponse_LocationsReportedResponse proto.InternalMessageInfo
+
+func (m *WebSocketResponse_LocationsReportedResponse) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*StartTrackingRequest)(nil), "pb.potpie.locationtracker.StartTrackingRequest")
+	proto.RegisterType((*StopTrackingRequest)(nil), "pb.potpie.locationtracker.StopTrackingRequest")
+	proto.RegisterType((*StopTrackingResponse)(nil), "pb.potpie.locationtracker.StopTrackingResponse")
+	proto.RegisterType((*TrackingData)(nil), "pb.potpie.locationtracker.TrackingData")
+	proto.RegisterType((*ReportLocationResponse)(nil), "pb.potpie.locationtracker.ReportLocationResponse")
+	proto.RegisterType((*PauseSharingRequest)(nil), "pb.potpie.locationtracker.PauseSharingRequest")
+	proto.RegisterType((*PauseSharingResponse)(nil), "pb.potpie.locationtracker.PauseSharingResponse")
+	proto.RegisterType((*ResumeSharingRequest)(nil), "pb.potpie.locationtracker.ResumeSharingRequest")
+	proto.RegisterType((*ResumeSharingResponse)(nil), "pb.potpie.locationtracker.ResumeSharingResponse")
+	proto.RegisterType((*RegisterRequest)(nil), "pb.potpie.locationtracker.RegisterRequest")
+	proto.RegisterType((*RegisterResponse)(nil), "pb.potpie.locationtracker.RegisterResponse")
+	proto.RegisterType((*StartSessionRequest)(nil), "pb.potpie.locationtracker.StartSessionRequest")
+	proto.RegisterType((*StartSessionResponse)(nil), "pb.potpie.locationtracker.StartSessionResponse")
+	proto.RegisterType((*StopSessionRequest)(nil), "pb.potpie.locationtracker.StopSessionRequest")
+	proto.RegisterType((*StopSessionResponse)(nil), "pb.potpie.locationtracker.StopSessionResponse")
+	proto.RegisterType((*GetTrackablesRequest)(nil), "pb.potpie.locationtracker.GetTrackablesRequest")
+	proto.RegisterType((*GetTrackablesResponse)(nil), "pb.potpie.locationtracker.GetTrackablesResponse")
+	proto.RegisterType((*SessionIdsRequest)(nil), "pb.potpie.locationtracker.SessionIdsRequest")
+	proto.RegisterType((*SessionId)(nil), "pb.potpie.locationtracker.SessionId")
+	proto.RegisterType((*SessionIdsResponse)(nil), "pb.potpie.locationtracker.SessionIdsResponse")
+	proto.RegisterType((*SessionDataRequest)(nil), "pb.potpie.locationtracker.SessionDataRequest")
+	proto.RegisterType((*SessionDataResponse)(nil), "pb.potpie.locationtracker.SessionDataResponse")
+	proto.RegisterType((*LoginRequest)(nil), "pb.potpie.locationtracker.LoginRequest")
+	proto.RegisterType((*LoginResponse)(nil), "pb.potpie.locationtracker.LoginResponse")
+	proto.RegisterType((*RefreshTokenRequest)(nil), "pb.potpie.locationtracker.RefreshTokenRequest")
+	proto.RegisterType((*RefreshTokenResponse)(nil), "pb.potpie.locationtracker.RefreshTokenResponse")
+	proto.RegisterType((*RevokeTokenRequest)(nil), "pb.potpie.locationtracker.RevokeTokenRequest")
+	proto.RegisterType((*RevokeTokenResponse)(nil), "pb.potpie.locationtracker.RevokeTokenResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "pb.potpie.locationtracker.ChangePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "pb.potpie.locationtracker.ChangePasswordResponse")
+	proto.RegisterType((*ApiKey)(nil), "pb.potpie.locationtracker.ApiKey")
+	proto.RegisterType((*CreateApiKeyRequest)(nil), "pb.potpie.locationtracker.CreateApiKeyRequest")
+	proto.RegisterType((*CreateApiKeyResponse)(nil), "pb.potpie.locationtracker.CreateApiKeyResponse")
+	proto.RegisterType((*ListApiKeysRequest)(nil), "pb.potpie.locationtracker.ListApiKeysRequest")
+	proto.RegisterType((*ListApiKeysResponse)(nil), "pb.potpie.locationtracker.ListApiKeysResponse")
+	proto.RegisterType((*LabelApiKeyRequest)(nil), "pb.potpie.locationtracker.LabelApiKeyRequest")
+	proto.RegisterType((*LabelApiKeyResponse)(nil), "pb.potpie.locationtracker.LabelApiKeyResponse")
+	proto.RegisterType((*RevokeApiKeyRequest)(nil), "pb.potpie.locationtracker.RevokeApiKeyRequest")
+	proto.RegisterType((*RevokeApiKeyResponse)(nil), "pb.potpie.locationtracker.RevokeApiKeyResponse")
+	proto.RegisterType((*RequestTrackingRequest)(nil), "pb.potpie.locationtracker.RequestTrackingRequest")
+	proto.RegisterType((*RequestTrackingResponse)(nil), "pb.potpie.locationtracker.RequestTrackingResponse")
+	proto.RegisterType((*ApproveTrackingRequest)(nil), "pb.potpie.locationtracker.ApproveTrackingRequest")
+	proto.RegisterType((*ApproveTrackingResponse)(nil), "pb.potpie.locationtracker.ApproveTrackingResponse")
+	proto.RegisterType((*DenyTrackingRequest)(nil), "pb.potpie.locationtracker.DenyTrackingRequest")
+	proto.RegisterType((*DenyTrackingResponse)(nil), "pb.potpie.locationtracker.DenyTrackingResponse")
+	proto.RegisterType((*RevokeTrackingRequest)(nil), "pb.potpie.locationtracker.RevokeTrackingRequest")
+	proto.RegisterType((*RevokeTrackingResponse)(nil), "pb.potpie.locationtracker.RevokeTrackingResponse")
+	proto.RegisterType((*GetWatchersRequest)(nil), "pb.potpie.locationtracker.GetWatchersRequest")
+	proto.RegisterType((*Watcher)(nil), "pb.potpie.locationtracker.Watcher")
+	proto.RegisterType((*GetWatchersResponse)(nil), "pb.potpie.locationtracker.GetWatchersResponse")
+	proto.RegisterType((*SetTrackingPrecisionRequest)(nil), "pb.potpie.locationtracker.SetTrackingPrecisionRequest")
+	proto.RegisterType((*SetTrackingPrecisionResponse)(nil), "pb.potpie.locationtracker.SetTrackingPrecisionResponse")
+	proto.RegisterType((*ShareLink)(nil), "pb.potpie.locationtracker.ShareLink")
+	proto.RegisterType((*CreateShareLinkRequest)(nil), "pb.potpie.locationtracker.CreateShareLinkRequest")
+	proto.RegisterType((*CreateShareLinkResponse)(nil), "pb.potpie.locationtracker.CreateShareLinkResponse")
+	proto.RegisterType((*ListShareLinksRequest)(nil), "pb.potpie.locationtracker.ListShareLinksRequest")
+	proto.RegisterType((*ListShareLinksResponse)(nil), "pb.potpie.locationtracker.ListShareLinksResponse")
+	proto.RegisterType((*RevokeShareLinkRequest)(nil), "pb.potpie.locationtracker.RevokeShareLinkRequest")
+	proto.RegisterType((*RevokeShareLinkResponse)(nil), "pb.potpie.locationtracker.RevokeShareLinkResponse")
+	proto.RegisterType((*Group)(nil), "pb.potpie.locationtracker.Group")
+	proto.RegisterType((*CreateGroupRequest)(nil), "pb.potpie.locationtracker.CreateGroupRequest")
+	proto.RegisterType((*CreateGroupResponse)(nil), "pb.potpie.locationtracker.CreateGroupResponse")
+	proto.RegisterType((*DeleteGroupRequest)(nil), "pb.potpie.locationtracker.DeleteGroupRequest")
+	proto.RegisterType((*DeleteGroupResponse)(nil), "pb.potpie.locationtracker.DeleteGroupResponse")
+	proto.RegisterType((*ListGroupsRequest)(nil), "pb.potpie.locationtracker.ListGroupsRequest")
+	proto.RegisterType((*ListGroupsResponse)(nil), "pb.potpie.locationtracker.ListGroupsResponse")
+	proto.RegisterType((*AddGroupMemberRequest)(nil), "pb.potpie.locationtracker.AddGroupMemberRequest")
+	proto.RegisterType((*AddGroupMemberResponse)(nil), "pb.potpie.locationtracker.AddGroupMemberResponse")
+	proto.RegisterType((*RemoveGroupMemberRequest)(nil), "pb.potpie.locationtracker.RemoveGroupMemberRequest")
+	proto.RegisterType((*RemoveGroupMemberResponse)(nil), "pb.potpie.locationtracker.RemoveGroupMemberResponse")
+	proto.RegisterType((*StartGroupTrackingRequest)(nil), "pb.potpie.locationtracker.StartGroupTrackingRequest")
+	proto.RegisterType((*DeleteAccountRequest)(nil), "pb.potpie.locationtracker.DeleteAccountRequest")
+	proto.RegisterType((*DeleteAccountResponse)(nil), "pb.potpie.locationtracker.DeleteAccountResponse")
+	proto.RegisterType((*ExportMyDataRequest)(nil), "pb.potpie.locationtracker.ExportMyDataRequest")
+	proto.RegisterType((*ExportMyDataResponse)(nil), "pb.potpie.locationtracker.ExportMyDataResponse")
+	proto.RegisterType((*SessionSummary)(nil), "pb.potpie.locationtracker.SessionSummary")
+	proto.RegisterType((*PrivacyZone)(nil), "pb.potpie.locationtracker.PrivacyZone")
+	proto.RegisterType((*CreatePrivacyZoneRequest)(nil), "pb.potpie.locationtracker.CreatePrivacyZoneRequest")
+	proto.RegisterType((*CreatePrivacyZoneResponse)(nil), "pb.potpie.locationtracker.CreatePrivacyZoneResponse")
+	proto.RegisterType((*ListPrivacyZonesRequest)(nil), "pb.potpie.locationtracker.ListPrivacyZonesRequest")
+	proto.RegisterType((*ListPrivacyZonesResponse)(nil), "pb.potpie.locationtracker.ListPrivacyZonesResponse")
+	proto.RegisterType((*DeletePrivacyZoneRequest)(nil), "pb.potpie.locationtracker.DeletePrivacyZoneRequest")
+	proto.RegisterType((*DeletePrivacyZoneResponse)(nil), "pb.potpie.locationtracker.DeletePrivacyZoneResponse")
+	proto.RegisterType((*AuditEntry)(nil), "pb.potpie.locationtracker.AuditEntry")
+	proto.RegisterType((*GetAuditLogRequest)(nil), "pb.potpie.locationtracker.GetAuditLogRequest")
+	proto.RegisterType((*GetAuditLogResponse)(nil), "pb.potpie.locationtracker.GetAuditLogResponse")
+	proto.RegisterType((*User)(nil), "pb.potpie.locationtracker.User")
+	proto.RegisterType((*ListUsersRequest)(nil), "pb.potpie.locationtracker.ListUsersRequest")
+	proto.RegisterType((*ListUsersResponse)(nil), "pb.potpie.locationtracker.ListUsersResponse")
+	proto.RegisterType((*DisableUserRequest)(nil), "pb.potpie.locationtracker.DisableUserRequest")
+	proto.RegisterType((*DisableUserResponse)(nil), "pb.potpie.locationtracker.DisableUserResponse")
+	proto.RegisterType((*EnableUserRequest)(nil), "pb.potpie.locationtracker.EnableUserRequest")
+	proto.RegisterType((*EnableUserResponse)(nil), "pb.potpie.locationtracker.EnableUserResponse")
+	proto.RegisterType((*SetUserRoleRequest)(nil), "pb.potpie.locationtracker.SetUserRoleRequest")
+	proto.RegisterType((*SetUserRoleResponse)(nil), "pb.potpie.locationtracker.SetUserRoleResponse")
+	proto.RegisterType((*Organization)(nil), "pb.potpie.locationtracker.Organization")
+	proto.RegisterType((*CreateOrganizationRequest)(nil), "pb.potpie.locationtracker.CreateOrganizationRequest")
+	proto.RegisterType((*CreateOrganizationResponse)(nil), "pb.potpie.locationtracker.CreateOrganizationResponse")
+	proto.RegisterType((*ListOrganizationsRequest)(nil), "pb.potpie.locationtracker.ListOrganizationsRequest")
+	proto.RegisterType((*ListOrganizationsResponse)(nil), "pb.potpie.locationtracker.ListOrganizationsResponse")
+	proto.RegisterType((*RetentionPolicy)(nil), "pb.potpie.locationtracker.RetentionPolicy")
+	proto.RegisterType((*GetRetentionPolicyRequest)(nil), "pb.potpie.locationtracker.GetRetentionPolicyRequest")
+	proto.RegisterType((*SetRetentionPolicyRequest)(nil), "pb.potpie.locationtracker.SetRetentionPolicyRequest")
+	proto.RegisterType((*SetRetentionPolicyResponse)(nil), "pb.potpie.locationtracker.SetRetentionPolicyResponse")
+	proto.RegisterType((*WebSocketRequest)(nil), "pb.potpie.locationtracker.WebSocketRequest")
+	proto.RegisterType((*WebSocketRequest_AuthenticateRequest)(nil), "pb.potpie.locationtracker.WebSocketRequest.AuthenticateRequest")
+	proto.RegisterType((*WebSocketRequest_ReportLocationRequest)(nil), "pb.potpie.locationtracker.WebSocketRequest.ReportLocationRequest")
+	proto.RegisterType((*WebSocketResponse)(nil), "pb.potpie.locationtracker.WebSocketResponse")
+	proto.RegisterType((*WebSocketResponse_AuthenticatedResponse)(nil), "pb.potpie.locationtracker.WebSocketResponse.AuthenticatedResponse")
+	proto.RegisterType((*WebSocketResponse_OkResponse)(nil), "pb.potpie.locationtracker.WebSocketResponse.OkResponse")
+	proto.RegisterType((*WebSocketResponse_ErrorResponse)(nil), "pb.potpie.locationtracker.WebSocketResponse.ErrorResponse")
+	proto.RegisterType((*WebSocketResponse_LocationsReportedResponse)(nil), "pb.potpie.locationtracker.WebSocketResponse.LocationsReportedResponse")
+}
+
+func init() { proto.RegisterFile("locationtracker.proto", fileDescriptor_1c19e669b665ab3c) }
+
+var fileDescriptor_1c19e669b665ab3c = []byte{
+	// 4051 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5c, 0xef, 0x72, 0xdc, 0xb6,
+	0xb5, 0xdf, 0x3f, 0xfa, 0xb7, 0x67, 0x25, 0x59, 0xc2, 0x4a, 0x32, 0xc5, 0xf8, 0x3a, 0x0a, 0xaf,
+	0x93, 0x28, 0x4e, 0x22, 0xd9, 0xb2, 0x93, 0xdc, 0x24, 0x77, 0x6e, 0xae, 0x1c, 0x3b, 0x52, 0x6e,
+	0x64, 0x47, 0x97, 0x92, 0xe3, 0x34, 0x49, 0x67, 0x4a, 0xef, 0x22, 0x6b, 0x46, 0xbb, 0xe4, 0x86,
+	0xe4, 0xda, 0x96, 0xd3, 0x4e, 0x3b, 0xd3, 0x99, 0x4e, 0x3b, 0xfd, 0xd8, 0x07, 0xe8, 0x5b, 0xf4,
+	0x73, 0xdb, 0x07, 0xc8, 0x97, 0xbe, 0x50, 0xe7, 0x00, 0x20, 0x09, 0x90, 0xd4, 0x92, 0x90, 0x3d,
+	0xfe, 0x26, 0x60, 0x71, 0x7e, 0xe7, 0xf0, 0xe0, 0x00, 0x38, 0x38, 0x3f, 0xd8, 0xb0, 0x3a, 0xf0,
+	0xbb, 0x4e, 0xe4, 0xfa, 0x5e, 0x14, 0x38, 0xdd, 0x13, 0x1a, 0x6c, 0x8d, 0x02, 0x3f, 0xf2, 0xc9,
+	0xfa, 0xe8, 0xe1, 0xd6, 0xc8, 0x8f, 0x46, 0x2e, 0xdd, 0xca, 0x0c, 0xb0, 0x8e, 0x61, 0xe5, 0x28,
+	0x72, 0x82, 0xe8, 0x18, 0xdb, 0xae, 0xd7, 0xb7, 0xe9, 0x8f, 0x63, 0x1a, 0x46, 0x64, 0x03, 0xda,
+	0x7c, 0x08, 0xbd, 0xe7, 0x0c, 0xa9, 0x51, 0xdf, 0xa8, 0x6f, 0xb6, 0x6c, 0xb9, 0x8b, 0x98, 0x30,
+	0x37, 0x0e, 0x69, 0xc0, 0x7e, 0x6e, 0xb0, 0x9f, 0x93, 0xb6, 0x75, 0x04, 0x9d, 0xa3, 0xc8, 0x1f,
+	0xbd, 0x58, 0xd0, 0x35, 0x58, 0x51, 0x41, 0xc3, 0x91, 0xef, 0x85, 0xd4, 0xfa, 0x67, 0x1d, 0xe6,
+	0xe3, 0xce, 0xdb, 0x4e, 0xe4, 0x54, 0x50, 0x73, 0x09, 0x5a, 0x03, 0xdf, 0xeb, 0xbb, 0xd1, 0xb8,
+	0xc7, 0xf5, 0xd4, 0xed, 0xb4, 0x03, 0x8d, 0x18, 0x38, 0x11, 0xff, 0xb1, 0xc9, 0x7e, 0x4c, 0xda,
+	0x28, 0x19, 0xb9, 0x43, 0x1a, 0x46, 0xce, 0x70, 0x64, 0x4c, 0x6d, 0xd4, 0x37, 0x9b, 0x76, 0xda,
+	0x41, 0xd6, 0x60, 0x26, 0x8c, 0x9c, 0x68, 0x1c, 0x1a, 0xd3, 0x4c, 0xa9, 0x68, 0xa1, 0x45, 0x23,
+	0x67, 0x1c, 0xd2, 0xde, 0x7d, 0x2f, 0x72, 0x07, 0xc6, 0x0c, 0x93, 0x93, 0xbb, 0x2c, 0x03, 0xd6,
+	0x6c, 0x3a, 0xf2, 0x83, 0xe8, 0x40, 0x4c, 0x50, 0xf2, 0x79, 0x7b, 0xd0, 0x39, 0xc4, 0x81, 0x47,
+	0x8f, 0x9c, 0x40, 0xf2, 0xa5, 0xec, 0xa9, 0xba, 0xea, 0x29, 0xb2, 0x02, 0xd3, 0x63, 0xa6, 0xa8,
+	0xc1, 0x14, 0xf1, 0x06, 0xfa, 0x4f, 0x05, 0x12, 0x0a, 0x76, 0x60, 0xc5, 0xa6, 0xe1, 0x78, 0xa8,
+	0xa1, 0xc1, 0xba, 0x08, 0xab, 0x19, 0x19, 0x01, 0xf6, 0xe7, 0x3a, 0x5c, 0xb0, 0x69, 0xdf, 0x0d,
+	0x23, 0x1a, 0x54, 0x31, 0x15, 0xfd, 0x89, 0x13, 0xe3, 0x3c, 0x1c, 0xf0, 0x99, 0x98, 0xb3, 0xd3,
+	0x0e, 0x94, 0x1c, 0x39, 0x61, 0xf8, 0xc4, 0x0f, 0x7a, 0x6c, 0x26, 0x5a, 0x76, 0xd2, 0x26, 0x16,
+	0xcc, 0xfb, 0x41, 0xdf, 0xf1, 0xdc, 0x67, 0xcc, 0x5f, 0x6c, 0x32, 0x5a, 0xb6, 0xd2, 0x67, 0x5d,
+	0x85, 0xa5, 0xd4, 0x18, 0x6e, 0x21, 0xce, 0x11, 0x6a, 0xff, 0xbc, 0xc7, 0x6c, 0x69, 0xda, 0xa2,
+	0x65, 0x5d, 0xc7, 0x98, 0x75, 0x82, 0xe8, 0x88, 0x86, 0x21, 0xf3, 0x7f, 0xb9, 0x17, 0x6e, 0xc2,
+	0x8a, 0x2a, 0x22, 0x54, 0x5c, 0x82, 0x56, 0xc8, 0xbb, 0x84, 0x96, 0x96, 0x9d, 0x76, 0x58, 0xd7,
+	0x80, 0x60, 0x1c, 0x6b, 0xe8, 0x59, 0x85, 0x8e, 0x22, 0x21, 0x7c, 0xbd, 0x06, 0x2b, 0x7b, 0x34,
+	0x3a, 0x8e, 0xbd, 0x15, 0x0a, 0x28, 0xeb, 0x06, 0xac, 0x66, 0xfa, 0x85, 0x5d, 0xaa, 0x8e, 0xa6,
+	0xa2, 0x63, 0x1b, 0x96, 0x8f, 0x62, 0x13, 0xc3, 0x2a, 0x46, 0x7d, 0x0b, 0xad, 0x44, 0x80, 0x6c,
+	0x64, 0xbf, 0xb8, 0x79, 0xab, 0x61, 0xd4, 0xa5, 0xaf, 0x56, 0x17, 0x4e, 0x23, 0xbb, 0x70, 0x16,
+	0xa1, 0xe1, 0xc6, 0x53, 0xdc, 0x70, 0x7b, 0xd6, 0xd7, 0x40, 0x64, 0x6b, 0x84, 0xfd, 0xb7, 0x54,
+	0x2d, 0xcd, 0xcd, 0xf6, 0xce, 0x95, 0xad, 0x33, 0xf7, 0xb6, 0xad, 0x04, 0x41, 0xf6, 0xfe, 0x67,
+	0x09, 0x32, 0xee, 0x15, 0xe9, 0xce, 0x54, 0x66, 0x3f, 0xb7, 0xb0, 0x91, 0x58, 0xf8, 0x10, 0x3a,
+	0x0a, 0x8e, 0x30, 0xf1, 0x0b, 0x98, 0x8f, 0xa4, 0xbd, 0x48, 0x58, 0xf9, 0xe6, 0x04, 0x2b, 0xe5,
+	0xad, 0xcb, 0x56, 0x84, 0xad, 0x1f, 0x60, 0xfe, 0xc0, 0xef, 0xbb, 0x55, 0x62, 0x44, 0x59, 0x2a,
+	0x8d, 0x92, 0xa5, 0xd2, 0x2c, 0x58, 0x2a, 0x21, 0x2c, 0x08, 0x5d, 0xe2, 0x4b, 0x36, 0xa0, 0xed,
+	0x74, 0xbb, 0x34, 0x0c, 0x8f, 0xfd, 0x13, 0xea, 0xc5, 0xbb, 0xa8, 0xd4, 0x85, 0xb0, 0x01, 0xfd,
+	0x3e, 0xa0, 0xe1, 0x23, 0x3e, 0x84, 0xab, 0x55, 0xfa, 0x70, 0xda, 0xe9, 0xd3, 0x91, 0x1b, 0xd0,
+	0x70, 0x37, 0x62, 0x7a, 0x9b, 0x76, 0xda, 0x61, 0x7d, 0x08, 0x1d, 0x5b, 0x1a, 0x1d, 0x7f, 0x67,
+	0x16, 0xb8, 0x9e, 0x07, 0xb6, 0x9e, 0xe1, 0xae, 0x25, 0x8b, 0xbe, 0x44, 0xb3, 0xff, 0x0b, 0x88,
+	0x4d, 0x1f, 0xfb, 0x27, 0x54, 0xdb, 0xea, 0x55, 0xe8, 0x28, 0x92, 0x62, 0x25, 0x3f, 0x81, 0xd5,
+	0x4f, 0x1f, 0x39, 0x5e, 0x9f, 0x1e, 0x8a, 0x29, 0xab, 0x32, 0xe3, 0x1b, 0xd0, 0xf6, 0x07, 0xbd,
+	0x43, 0x75, 0xd2, 0xe5, 0x2e, 0x1c, 0xe1, 0xd1, 0x27, 0x87, 0xea, 0x0e, 0x2a, 0x77, 0xe1, 0xb1,
+	0x93, 0x55, 0x2c, 0x4c, 0x0a, 0x60, 0x66, 0x77, 0xe4, 0x7e, 0x41, 0x4f, 0xf1, 0x34, 0x39, 0xa1,
+	0xa7, 0xc9, 0x4e, 0xc6, 0x1b, 0xd8, 0x3b, 0x70, 0x1e, 0xd2, 0x81, 0xd0, 0xcb, 0x1b, 0xe8, 0xb7,
+	0x6e, 0x40, 0x9d, 0x88, 0xf6, 0x52, 0xbf, 0x25, 0x1d, 0xe4, 0x32, 0xc0, 0xc0, 0x09, 0xa3, 0xfb,
+	0x21, 0xfb, 0x99, 0x9f, 0x9e, 0x52, 0x0f, 0x1e, 0x75, 0x9f, 0xb2, 0xc1, 0x5c, 0x73, 0xc5, 0xa3,
+	0x2e, 0x6f, 0x86, 0xd5, 0x85, 0x15, 0x15, 0x48, 0x04, 0xc7, 0x87, 0x30, 0xe3, 0xb0, 0x1e, 0x86,
+	0xd3, 0xde, 0x79, 0x6d, 0xc2, 0xba, 0x14, 0xa2, 0x42, 0x80, 0x2c, 0x41, 0xf3, 0x84, 0x9e, 0x0a,
+	0x35, 0xf8, 0x27, 0xee, 0xe3, 0x07, 0x6e, 0x18, 0xf1, 0x71, 0x95, 0xb6, 0xcc, 0x43, 0xe8, 0x28,
+	0x12, 0x05, 0x56, 0x35, 0xb5, 0xac, 0xb2, 0xbe, 0x03, 0x72, 0x80, 0x5f, 0xac, 0xe5, 0x30, 0x3e,
+	0x9b, 0x8d, 0xc2, 0xd9, 0x6c, 0xca, 0x6e, 0x5c, 0x85, 0x8e, 0x82, 0x9e, 0x66, 0x24, 0x3c, 0x88,
+	0x9f, 0x53, 0x2b, 0x1e, 0x60, 0x2a, 0x90, 0x50, 0xf0, 0x15, 0xac, 0x09, 0xd0, 0x17, 0x9b, 0x41,
+	0xae, 0xc3, 0xc5, 0x1c, 0x6e, 0xaa, 0x72, 0x77, 0x34, 0x0a, 0xfc, 0xc7, 0xf4, 0x85, 0xab, 0xcc,
+	0xe1, 0x0a, 0x95, 0x47, 0xd0, 0xb9, 0x4d, 0xbd, 0xd3, 0x17, 0x9e, 0x24, 0xab, 0xa0, 0x42, 0xd9,
+	0x7d, 0x58, 0xe5, 0xae, 0x7e, 0xb1, 0xea, 0x58, 0xda, 0xaa, 0xc2, 0x0a, 0x85, 0xd7, 0x80, 0xec,
+	0xd1, 0xe8, 0x81, 0x13, 0x75, 0x1f, 0xd1, 0xa0, 0xd2, 0xea, 0x70, 0x60, 0x56, 0x0c, 0x2f, 0xcd,
+	0x18, 0xcf, 0x4e, 0x24, 0x2e, 0x41, 0x6b, 0x14, 0xd0, 0xae, 0x1b, 0xa6, 0xe7, 0x5c, 0xda, 0x61,
+	0xfd, 0xa5, 0x0e, 0x1d, 0xc5, 0x2a, 0xb1, 0x02, 0xff, 0x1b, 0x66, 0x47, 0xd4, 0xeb, 0xb9, 0x5e,
+	0x5f, 0x2c, 0x41, 0x6b, 0xc2, 0x12, 0x14, 0xd2, 0x76, 0x2c, 0x42, 0xfe, 0x07, 0xe6, 0x1c, 0x3e,
+	0xc7, 0x18, 0xdf, 0x55, 0xc5, 0x13, 0x19, 0xeb, 0x14, 0x5e, 0x39, 0xa2, 0x49, 0x48, 0x1e, 0xc6,
+	0xd6, 0xbe, 0x90, 0x19, 0x2a, 0x71, 0xc8, 0x65, 0xb8, 0x54, 0xac, 0x5a, 0xcc, 0xe2, 0x6f, 0xa1,
+	0x85, 0x19, 0x3e, 0x3d, 0x70, 0xbd, 0x13, 0x62, 0xc0, 0x6c, 0x88, 0x8d, 0xe4, 0x28, 0x88, 0x9b,
+	0x6a, 0xc2, 0xdb, 0xc8, 0x24, 0xbc, 0x25, 0x87, 0x82, 0x72, 0xd4, 0x4e, 0x65, 0x8f, 0xda, 0x5f,
+	0xc3, 0x1a, 0xdf, 0xc9, 0x13, 0x33, 0xaa, 0x6c, 0x37, 0x9b, 0x70, 0xa1, 0x37, 0x0e, 0x98, 0xdb,
+	0x8f, 0x68, 0xd7, 0xf7, 0x7a, 0xa1, 0x88, 0x94, 0x6c, 0xb7, 0x6a, 0x79, 0x33, 0x9b, 0xaa, 0x87,
+	0x70, 0x31, 0xa7, 0x5d, 0xca, 0x45, 0xe3, 0x4e, 0x71, 0x9a, 0x4c, 0xcc, 0x45, 0x13, 0x80, 0x54,
+	0x0c, 0x77, 0xc5, 0x48, 0x4a, 0x41, 0x78, 0x03, 0xd3, 0x77, 0x3c, 0x25, 0x12, 0x89, 0x4a, 0x8b,
+	0xe7, 0x3b, 0x58, 0xcb, 0x0a, 0x15, 0x1b, 0xda, 0x3c, 0x87, 0xa1, 0xd6, 0xbd, 0x78, 0x99, 0x6b,
+	0xcd, 0x82, 0x14, 0x2f, 0x0d, 0x25, 0x5e, 0xf8, 0x46, 0x9c, 0xc1, 0x13, 0x11, 0xf7, 0x2d, 0x4c,
+	0xef, 0x05, 0xfe, 0x98, 0xad, 0xe4, 0x3e, 0xfe, 0x21, 0x41, 0xa7, 0x1d, 0x78, 0x8b, 0x1b, 0xd2,
+	0xe1, 0x43, 0x1a, 0xb0, 0x15, 0xd7, 0xb2, 0x45, 0x6b, 0x72, 0xac, 0x59, 0xf7, 0x80, 0xf0, 0xf9,
+	0x64, 0x2a, 0x2a, 0xde, 0x4f, 0x53, 0x2b, 0x1a, 0x19, 0x2b, 0xac, 0xbb, 0xd0, 0x51, 0xf0, 0x84,
+	0xcb, 0xdf, 0x87, 0x69, 0x36, 0x46, 0xc4, 0xc5, 0xc6, 0x04, 0x77, 0x73, 0x41, 0x3e, 0x1c, 0xcd,
+	0xbb, 0x4d, 0x07, 0xf4, 0x85, 0x99, 0xb7, 0x0a, 0x1d, 0x05, 0x4f, 0xb8, 0x78, 0x1b, 0x96, 0x31,
+	0x56, 0x58, 0x67, 0xa5, 0xe0, 0x3a, 0x00, 0x22, 0x0b, 0xe4, 0xbf, 0xb2, 0xa9, 0xf3, 0x95, 0x21,
+	0xac, 0xee, 0xf6, 0x7a, 0xac, 0xeb, 0x2e, 0x9b, 0xb4, 0xe7, 0xfe, 0xd0, 0xec, 0x16, 0xd9, 0xcc,
+	0x6d, 0x91, 0x78, 0x50, 0x65, 0x95, 0x0a, 0x6f, 0x3c, 0x06, 0xc3, 0xa6, 0x43, 0xff, 0x31, 0x7d,
+	0xc9, 0x16, 0xbd, 0x02, 0xeb, 0x05, 0x7a, 0x93, 0xe3, 0x7a, 0x9d, 0x55, 0x16, 0xd8, 0x6f, 0xd9,
+	0x23, 0xfb, 0xfc, 0x01, 0x71, 0x0f, 0x56, 0x78, 0x40, 0xec, 0x76, 0xbb, 0xfe, 0xd8, 0x8b, 0x9e,
+	0xf3, 0x62, 0x89, 0x65, 0xa0, 0x0c, 0x9e, 0xb0, 0xff, 0x3a, 0x74, 0xee, 0x3c, 0x1d, 0xf9, 0x41,
+	0x74, 0xf7, 0x54, 0xbe, 0x66, 0x4f, 0x0e, 0xb2, 0x15, 0x55, 0x44, 0x84, 0x19, 0x81, 0xa9, 0x1e,
+	0xbf, 0x49, 0xd7, 0x37, 0xe7, 0x6d, 0xf6, 0x37, 0x7a, 0xb7, 0xeb, 0x7b, 0x11, 0xf5, 0xa2, 0xe3,
+	0xd3, 0x51, 0xfc, 0x9d, 0x72, 0x97, 0xf5, 0x73, 0x03, 0x16, 0xc5, 0xfd, 0xfc, 0x68, 0x3c, 0x1c,
+	0x3a, 0xc1, 0xa9, 0xb8, 0xc1, 0xd7, 0xe3, 0x1b, 0x3c, 0xdb, 0xfa, 0xd1, 0xc7, 0x6c, 0xab, 0x10,
+	0x89, 0x44, 0xd2, 0x81, 0x9b, 0x17, 0xf5, 0x7a, 0xd2, 0x36, 0x12, 0x37, 0x71, 0xeb, 0x19, 0xf9,
+	0xae, 0x17, 0x85, 0xe2, 0xb4, 0x12, 0x2d, 0xfc, 0xb8, 0x9e, 0x1b, 0x46, 0x8e, 0xd7, 0xa5, 0xac,
+	0xfc, 0x57, 0xb7, 0x93, 0x36, 0x1a, 0x3c, 0x74, 0xbd, 0x83, 0xb8, 0xaa, 0x38, 0xc3, 0x7e, 0x96,
+	0xbb, 0xf0, 0xf6, 0x88, 0xcd, 0xa4, 0x2a, 0x39, 0xcb, 0x86, 0x28, 0x7d, 0x0c, 0xc5, 0x79, 0x9a,
+	0xa0, 0xcc, 0x09, 0x14, 0xe7, 0xa9, 0x82, 0xe2, 0x3c, 0x4d, 0x51, 0x5a, 0x02, 0x45, 0xea, 0x63,
+	0xf6, 0x8f, 0x83, 0x3e, 0xed, 0x19, 0xc0, 0xea, 0x6d, 0xa2, 0xc5, 0xb6, 0x4e, 0x7f, 0x38, 0x72,
+	0xba, 0x11, 0xed, 0x19, 0x6d, 0xf6, 0x53, 0xda, 0x61, 0xfd, 0xb5, 0x0e, 0xed, 0xc3, 0xc0, 0x7d,
+	0xec, 0x74, 0x4f, 0xbf, 0xf1, 0x3d, 0x86, 0xf2, 0xcc, 0xf7, 0xd2, 0x5c, 0x40, 0xb4, 0x70, 0xba,
+	0xbc, 0x34, 0xf6, 0xd8, 0xdf, 0x65, 0x05, 0xd5, 0xb4, 0x14, 0x3b, 0x95, 0x2d, 0xc5, 0xae, 0xc1,
+	0x4c, 0xe0, 0xf4, 0x5c, 0x51, 0x50, 0xad, 0xdb, 0xa2, 0x85, 0x5a, 0x86, 0xbe, 0x70, 0x64, 0xcb,
+	0x66, 0x7f, 0x5b, 0x01, 0x18, 0x7c, 0x33, 0x96, 0xcc, 0xac, 0x12, 0xe0, 0x1f, 0xc1, 0x14, 0xda,
+	0xce, 0x2c, 0x6e, 0xef, 0xbc, 0x31, 0x61, 0x1b, 0x93, 0x81, 0x99, 0x8c, 0xf5, 0x00, 0xd6, 0x0b,
+	0x74, 0x8a, 0xc8, 0x8d, 0x81, 0xeb, 0xe7, 0x00, 0x7e, 0x0f, 0x2e, 0xe2, 0x96, 0x2b, 0xfd, 0x50,
+	0x69, 0xa7, 0xfe, 0x0a, 0x8c, 0xbc, 0x58, 0xce, 0x9c, 0xa6, 0xb6, 0x39, 0xf7, 0xc0, 0xe0, 0x0b,
+	0x5d, 0xd3, 0xb7, 0x69, 0x94, 0x34, 0xe4, 0x28, 0xc1, 0xcd, 0xaf, 0x00, 0x4f, 0x6c, 0x1e, 0x7f,
+	0xaf, 0x03, 0xec, 0x8e, 0x7b, 0x6e, 0x74, 0xc7, 0x8b, 0x82, 0x53, 0x35, 0xe1, 0xaf, 0x67, 0x13,
+	0xfe, 0x15, 0x98, 0x76, 0xba, 0x91, 0x1f, 0xc4, 0x39, 0x14, 0x6b, 0xa0, 0xde, 0xc8, 0x09, 0xfa,
+	0x34, 0x12, 0x3b, 0xaf, 0x68, 0x61, 0xbf, 0xd3, 0x95, 0xca, 0xc5, 0xa2, 0x25, 0xca, 0xd0, 0x5e,
+	0x88, 0xfb, 0x8f, 0xa8, 0xdd, 0xa7, 0x1d, 0xb8, 0x17, 0xf8, 0xe3, 0xa8, 0xeb, 0x0f, 0xe3, 0x80,
+	0x8b, 0x9b, 0x2c, 0x3e, 0xa9, 0x13, 0xfa, 0x1e, 0x5b, 0xaf, 0x2d, 0x5b, 0xb4, 0xf0, 0x5e, 0xbe,
+	0x47, 0x23, 0xf6, 0x11, 0x07, 0x7e, 0xd5, 0x9a, 0x7d, 0xe8, 0xe2, 0xd6, 0x21, 0x6a, 0xf6, 0xac,
+	0x81, 0xbd, 0x03, 0x77, 0xe8, 0xf2, 0xcf, 0x98, 0xb6, 0x79, 0xc3, 0xb2, 0xa1, 0xa3, 0xa0, 0x8b,
+	0x09, 0xfe, 0x18, 0xa6, 0x29, 0x7a, 0x4c, 0xcc, 0xf0, 0xeb, 0x93, 0xca, 0x08, 0x89, 0x7b, 0x6d,
+	0x2e, 0x63, 0xfd, 0xb1, 0x0e, 0x53, 0xf7, 0x43, 0x1a, 0x9c, 0x55, 0x1f, 0x9f, 0x78, 0xc9, 0x20,
+	0x30, 0x15, 0xf8, 0x83, 0xf8, 0x98, 0x63, 0x7f, 0xab, 0x95, 0xfd, 0xa9, 0x82, 0xca, 0x7e, 0xcf,
+	0x0d, 0xf1, 0xcf, 0x1e, 0xf3, 0xf7, 0x9c, 0x9d, 0xb4, 0xad, 0x03, 0x58, 0xc2, 0x20, 0x46, 0x6b,
+	0x92, 0xa0, 0xc7, 0x6b, 0x8c, 0xd3, 0xa7, 0x72, 0x65, 0x2d, 0xed, 0xe0, 0x67, 0x54, 0x9f, 0x1e,
+	0xb9, 0xcf, 0xb8, 0x6d, 0xd3, 0x76, 0xd2, 0xb6, 0x3c, 0x58, 0x96, 0xd0, 0x84, 0xab, 0x6e, 0xc0,
+	0x14, 0x1a, 0x2f, 0x3c, 0xf5, 0xea, 0x04, 0x4f, 0xa1, 0x9c, 0xcd, 0x06, 0x93, 0x2b, 0xb0, 0xe0,
+	0xd1, 0xa7, 0xd1, 0x61, 0x62, 0x07, 0x77, 0x83, 0xda, 0x89, 0x17, 0xdf, 0xdb, 0xfc, 0x4b, 0x98,
+	0x68, 0xb5, 0xf2, 0xbe, 0x22, 0x91, 0xa6, 0x69, 0x77, 0x3c, 0x1d, 0x9c, 0x15, 0x20, 0x77, 0xbc,
+	0x1c, 0xcc, 0x6d, 0x2c, 0x78, 0xb3, 0xcf, 0xb7, 0xfd, 0x41, 0xa5, 0x45, 0x1b, 0xcf, 0x66, 0x23,
+	0x9d, 0x4d, 0x46, 0x41, 0xc8, 0x28, 0x02, 0xfc, 0x7f, 0x61, 0xfe, 0x4b, 0xa9, 0x8a, 0x9c, 0xec,
+	0xfe, 0x75, 0x69, 0xf7, 0x57, 0x52, 0xf2, 0x46, 0x36, 0x25, 0xff, 0x29, 0xde, 0x41, 0x65, 0x9c,
+	0xd8, 0xca, 0x22, 0xb8, 0x2b, 0xb0, 0xe0, 0xf4, 0x86, 0xae, 0x77, 0x5f, 0x0d, 0x46, 0xb5, 0x33,
+	0x19, 0x95, 0x29, 0x7e, 0xaa, 0x9d, 0xd6, 0x25, 0x30, 0x8b, 0x94, 0x8b, 0x8f, 0x33, 0xf9, 0x66,
+	0x2a, 0xff, 0x96, 0x70, 0x2c, 0x8f, 0x60, 0xbd, 0xe0, 0xb7, 0x94, 0x04, 0x50, 0xea, 0xed, 0xe5,
+	0x24, 0x80, 0xa2, 0x5f, 0x11, 0xb6, 0xfa, 0x48, 0xa8, 0x45, 0xd4, 0xc3, 0xc6, 0xa1, 0x3f, 0x70,
+	0xbb, 0xa7, 0xe4, 0x2a, 0x2c, 0xc5, 0x00, 0x61, 0x7c, 0xbf, 0xe5, 0x8b, 0x35, 0xd7, 0x8f, 0x57,
+	0x61, 0x71, 0x9f, 0x0d, 0x33, 0x57, 0xe1, 0x4c, 0xb7, 0xf5, 0x01, 0xac, 0xef, 0xd1, 0x28, 0xa3,
+	0xab, 0x4a, 0xdc, 0xfd, 0x04, 0xeb, 0x47, 0xe7, 0x11, 0x24, 0xb7, 0x30, 0x93, 0xc2, 0xc1, 0xe2,
+	0xec, 0xbd, 0x3a, 0xc1, 0x43, 0x59, 0x78, 0x21, 0x89, 0x53, 0x58, 0xa4, 0x5c, 0x4c, 0xe1, 0xcf,
+	0x97, 0x61, 0xe9, 0x01, 0x7d, 0x78, 0xe4, 0x77, 0x4f, 0x68, 0x24, 0xed, 0x25, 0x01, 0xff, 0x33,
+	0xa5, 0xe7, 0x92, 0x0e, 0xf2, 0x00, 0x16, 0x42, 0x99, 0x11, 0x17, 0xb6, 0x6d, 0x4f, 0xba, 0x33,
+	0x17, 0x30, 0xe8, 0xfb, 0x35, 0x5b, 0xc5, 0x21, 0xc7, 0x30, 0x1f, 0x4a, 0xfc, 0x35, 0x8b, 0xc8,
+	0xf6, 0xce, 0xd6, 0x44, 0xdc, 0x1c, 0x87, 0xbe, 0x5f, 0xb3, 0x15, 0x14, 0x34, 0xb7, 0x2f, 0x93,
+	0x7d, 0xc6, 0x54, 0xa9, 0xb9, 0x45, 0xa4, 0x21, 0x9a, 0xab, 0xe0, 0x90, 0x7d, 0x98, 0x0b, 0x04,
+	0x77, 0x6a, 0x4c, 0x57, 0x98, 0x1e, 0x85, 0xf3, 0xdd, 0xaf, 0xd9, 0x89, 0x34, 0x39, 0x66, 0x26,
+	0xa6, 0x7c, 0x1e, 0x3b, 0x44, 0xdb, 0x3b, 0xef, 0x54, 0xa1, 0xee, 0x32, 0xf6, 0xa5, 0xfd, 0xe4,
+	0x01, 0x2c, 0xa6, 0x1d, 0x8c, 0x6b, 0x9b, 0x65, 0xb0, 0xef, 0x96, 0xc3, 0x4a, 0x57, 0x92, 0xfd,
+	0x9a, 0x9d, 0x81, 0x21, 0x9f, 0xc0, 0xf4, 0x00, 0x99, 0x30, 0x96, 0x5f, 0x4f, 0x5e, 0xb6, 0x32,
+	0x3b, 0xb7, 0x5f, 0xb3, 0xb9, 0x1c, 0x4e, 0xb4, 0x42, 0x04, 0xb5, 0x4a, 0x27, 0xba, 0x80, 0x04,
+	0xc3, 0x89, 0x96, 0x51, 0xc8, 0xff, 0x43, 0x3b, 0x48, 0xa9, 0x23, 0x03, 0x4a, 0x3f, 0x36, 0x4f,
+	0x51, 0xed, 0xd7, 0x6c, 0x19, 0x83, 0x7c, 0x03, 0x8b, 0x5d, 0x85, 0xfd, 0x61, 0x69, 0x7f, 0x7b,
+	0xe7, 0xda, 0x04, 0xd4, 0x42, 0x9e, 0x0a, 0xbd, 0xa8, 0x22, 0x11, 0x0a, 0xf3, 0xce, 0x38, 0x7a,
+	0x84, 0xab, 0xb2, 0xeb, 0x44, 0xd4, 0x98, 0x67, 0xc8, 0x9f, 0x4c, 0x2a, 0x8c, 0x66, 0xd6, 0xe9,
+	0xd6, 0xae, 0x24, 0x2f, 0x79, 0x45, 0x86, 0x45, 0x5f, 0x77, 0x25, 0xa6, 0xc7, 0x58, 0x28, 0xf5,
+	0x75, 0x01, 0xc3, 0x84, 0xa8, 0x32, 0x0a, 0xfa, 0x7a, 0x90, 0x12, 0x35, 0xc6, 0x62, 0xa9, 0xaf,
+	0xf3, 0x44, 0x10, 0xfa, 0x5a, 0xc2, 0x60, 0x90, 0x29, 0x97, 0x62, 0x5c, 0x28, 0x87, 0xcc, 0xf1,
+	0x3a, 0x0c, 0x32, 0xed, 0xe5, 0x71, 0x96, 0xd2, 0x27, 0xc6, 0x52, 0x85, 0x38, 0xcb, 0xd1, 0x36,
+	0x3c, 0xce, 0xd2, 0x6e, 0xf2, 0x4b, 0xb8, 0x10, 0xa8, 0x24, 0x89, 0xb1, 0xcc, 0x80, 0xaf, 0x4f,
+	0x04, 0x2e, 0xa2, 0x6b, 0xf6, 0x6b, 0x76, 0x16, 0x0b, 0xe1, 0x1d, 0x95, 0x10, 0x31, 0x48, 0x29,
+	0x7c, 0x31, 0x35, 0x83, 0xf0, 0x19, 0x2c, 0xf4, 0x49, 0x4f, 0xe2, 0x3f, 0x8c, 0x4e, 0xa9, 0x4f,
+	0x0a, 0x38, 0x18, 0xf4, 0x89, 0x8c, 0x82, 0x0b, 0x25, 0x50, 0x68, 0x0e, 0x63, 0xa5, 0x74, 0xa1,
+	0x14, 0xd2, 0x2d, 0xb8, 0x50, 0x54, 0x24, 0x0c, 0x8c, 0x7e, 0x4a, 0x49, 0x18, 0xab, 0xa5, 0x81,
+	0x91, 0xa7, 0x55, 0x30, 0x30, 0x24, 0x0c, 0xf4, 0x71, 0x57, 0x2d, 0x5b, 0x1b, 0x6b, 0xa5, 0x3e,
+	0x2e, 0x2e, 0xb3, 0xa3, 0x8f, 0x33, 0x58, 0xe8, 0x8d, 0x81, 0x52, 0x6b, 0x36, 0x2e, 0x96, 0x7a,
+	0xa3, 0xb0, 0xa2, 0x8d, 0xde, 0x50, 0x91, 0x78, 0xf4, 0x29, 0x95, 0x61, 0xc3, 0xa8, 0x10, 0x7d,
+	0x45, 0xb5, 0x69, 0x1e, 0x7d, 0xca, 0x2f, 0xe8, 0xec, 0x6e, 0x5a, 0xb0, 0x35, 0xd6, 0x4b, 0x9d,
+	0x9d, 0x2f, 0x17, 0xa3, 0xb3, 0x25, 0x0c, 0x84, 0xec, 0xa5, 0x45, 0x56, 0xc3, 0x2c, 0x85, 0xcc,
+	0x97, 0x78, 0x11, 0x52, 0xc2, 0x20, 0xf7, 0x00, 0x06, 0x49, 0xbd, 0xd5, 0x78, 0xa5, 0xf4, 0xb4,
+	0xcc, 0x55, 0x73, 0xf7, 0x6b, 0xb6, 0x84, 0x80, 0x13, 0xe6, 0x28, 0xc5, 0x4f, 0xe3, 0x52, 0xe9,
+	0x84, 0x15, 0x96, 0x68, 0x71, 0xc2, 0x54, 0x24, 0xd2, 0x85, 0xe5, 0x20, 0x5b, 0xc6, 0x34, 0xfe,
+	0x83, 0xc1, 0xdf, 0x98, 0x38, 0x65, 0xc5, 0x25, 0xd7, 0xfd, 0x9a, 0x9d, 0xc7, 0x23, 0xdf, 0x03,
+	0x09, 0x73, 0xe5, 0x50, 0xe3, 0x32, 0xd3, 0x72, 0xb3, 0x2c, 0x31, 0x2b, 0xaa, 0xa1, 0xee, 0xd7,
+	0xec, 0x02, 0x44, 0x4c, 0xa6, 0x7a, 0x72, 0x3d, 0xd3, 0x78, 0xb5, 0x34, 0x99, 0x2a, 0xaa, 0xa7,
+	0x62, 0xb2, 0xa2, 0xe0, 0xe0, 0xb6, 0x44, 0xa5, 0xe2, 0xa6, 0xb1, 0x51, 0xba, 0x2d, 0x15, 0x94,
+	0x4f, 0x71, 0x5b, 0x92, 0x51, 0xd0, 0xf7, 0xdd, 0x6c, 0xf5, 0xc9, 0x78, 0xad, 0xd4, 0xf7, 0x67,
+	0x55, 0xc9, 0xd0, 0xf7, 0x39, 0x3c, 0xf2, 0x2b, 0x58, 0x1a, 0x64, 0x4a, 0x4a, 0x86, 0xc5, 0x74,
+	0xec, 0x94, 0x84, 0x64, 0x41, 0xf1, 0x6a, 0xbf, 0x66, 0xe7, 0xd0, 0xf0, 0x33, 0x7a, 0xd9, 0x62,
+	0x90, 0xf1, 0x9f, 0xa5, 0x9f, 0x71, 0x56, 0x41, 0x0a, 0x3f, 0x23, 0x87, 0x47, 0x06, 0xb0, 0x12,
+	0x16, 0x30, 0x9d, 0xc6, 0x15, 0xa6, 0xe7, 0xfd, 0x89, 0x49, 0xe3, 0x99, 0xdc, 0xec, 0x7e, 0xcd,
+	0x2e, 0x44, 0xc5, 0xf9, 0x1e, 0x49, 0x6f, 0x2d, 0x8d, 0xd7, 0x4b, 0xe7, 0xbb, 0xe0, 0x8d, 0x27,
+	0xce, 0xb7, 0x8c, 0x82, 0xe1, 0x19, 0xc8, 0xaf, 0x2e, 0x8d, 0x37, 0x4a, 0xc3, 0xb3, 0xe8, 0x65,
+	0x27, 0x86, 0xa7, 0x82, 0x23, 0xce, 0xa0, 0xb8, 0xa0, 0x64, 0xbc, 0x59, 0xe5, 0x0c, 0xca, 0x14,
+	0xb7, 0xc4, 0x19, 0x14, 0xf7, 0x92, 0x13, 0x3c, 0x32, 0xe5, 0x07, 0xad, 0xc6, 0x26, 0x43, 0xdd,
+	0xd5, 0xc9, 0x00, 0xb3, 0x4f, 0x62, 0xa5, 0x33, 0x54, 0xfe, 0x81, 0x5f, 0xad, 0xd2, 0x87, 0x98,
+	0xc6, 0x5b, 0x15, 0xae, 0x56, 0xb9, 0xa7, 0x9e, 0xfc, 0x6a, 0x95, 0x76, 0xa3, 0x57, 0xc2, 0xf4,
+	0xd9, 0xa5, 0x71, 0xb5, 0xfc, 0x7a, 0x91, 0x7b, 0xd6, 0x89, 0x5e, 0x91, 0x30, 0xcc, 0xb7, 0xa1,
+	0x53, 0x90, 0xd5, 0xa6, 0x44, 0x70, 0x5d, 0x22, 0x82, 0xcd, 0x3f, 0xd4, 0xf1, 0xd1, 0x46, 0x81,
+	0x07, 0x2a, 0x3c, 0x09, 0xc8, 0xbe, 0x43, 0x6c, 0x3c, 0xc7, 0x3b, 0xc4, 0x5b, 0x2d, 0x98, 0x15,
+	0x69, 0x9c, 0xf5, 0xb7, 0x25, 0x58, 0x96, 0xa6, 0x29, 0x7d, 0xf0, 0x3a, 0xe1, 0x46, 0x6d, 0x03,
+	0x44, 0xe9, 0xfd, 0xb4, 0x51, 0x7a, 0xf4, 0x14, 0x3e, 0x5e, 0xc5, 0x23, 0x2d, 0x45, 0x21, 0x77,
+	0x01, 0xe2, 0xfb, 0x25, 0xed, 0x89, 0xab, 0xf4, 0xdb, 0x95, 0xee, 0xa7, 0x29, 0x5c, 0x0a, 0x40,
+	0xbe, 0x04, 0x08, 0xd3, 0xfb, 0xe9, 0x54, 0xd5, 0x8b, 0xa4, 0xf4, 0x38, 0x15, 0x01, 0x53, 0x08,
+	0x62, 0x43, 0x3b, 0x94, 0xae, 0xa6, 0xd3, 0xe5, 0x01, 0x99, 0x7f, 0x4c, 0xca, 0x82, 0x27, 0xed,
+	0x26, 0x77, 0x33, 0x73, 0x3a, 0x53, 0x7a, 0x3f, 0x95, 0xe7, 0x14, 0xc3, 0x5b, 0x16, 0xc7, 0xea,
+	0x0b, 0x8b, 0xb3, 0x50, 0x5c, 0x9c, 0x37, 0xcb, 0x2f, 0xba, 0x89, 0x5d, 0x42, 0x92, 0xfc, 0x00,
+	0x0b, 0xf2, 0x75, 0xac, 0x27, 0xee, 0xcc, 0xb7, 0xaa, 0x2d, 0x72, 0x0e, 0xa7, 0xdc, 0xf3, 0x7a,
+	0x92, 0x12, 0x15, 0x9a, 0x7c, 0x9e, 0x3c, 0x93, 0x6b, 0x95, 0x6e, 0x7b, 0x45, 0xaf, 0xff, 0xd0,
+	0x6c, 0x0e, 0x40, 0xfe, 0x0f, 0x66, 0x1d, 0x71, 0xb7, 0x83, 0xd2, 0x99, 0x29, 0x78, 0xb2, 0xb7,
+	0x5f, 0xb3, 0x63, 0x00, 0x72, 0x00, 0x73, 0x4f, 0xe2, 0xe4, 0xbd, 0x5d, 0x0a, 0x56, 0xf0, 0xfa,
+	0x08, 0x6b, 0x25, 0x31, 0x02, 0xb1, 0xe5, 0xd7, 0x1a, 0xf3, 0xa5, 0xc7, 0xec, 0x19, 0xaf, 0x53,
+	0xf6, 0x6b, 0xf2, 0x33, 0x93, 0x23, 0x80, 0x30, 0xcd, 0xd5, 0x17, 0x4a, 0xd3, 0xe9, 0xe2, 0x87,
+	0x24, 0x2c, 0xc0, 0x93, 0x5e, 0xf2, 0x59, 0xcc, 0xfe, 0x2f, 0x56, 0xbc, 0x71, 0x2b, 0x6f, 0x10,
+	0xb0, 0x58, 0xc2, 0xc4, 0xc9, 0x1e, 0xcc, 0xf4, 0x79, 0x9e, 0x7b, 0xa1, 0xd2, 0x2d, 0x5b, 0x7d,
+	0x84, 0x80, 0x73, 0xca, 0xc5, 0x31, 0x3c, 0x78, 0x72, 0x64, 0x2c, 0x95, 0x86, 0x47, 0x11, 0xd1,
+	0x8c, 0x50, 0x1c, 0x80, 0x7c, 0x0d, 0xed, 0x91, 0x94, 0x8a, 0x2c, 0x97, 0xe6, 0x99, 0x67, 0x72,
+	0x80, 0xb8, 0x84, 0x25, 0x28, 0xf2, 0x0b, 0x98, 0x1f, 0xc9, 0x89, 0x14, 0x29, 0xcd, 0x72, 0xce,
+	0xa2, 0xf3, 0x58, 0x72, 0x20, 0xf5, 0x63, 0x1c, 0x3a, 0xf1, 0x01, 0xde, 0xa9, 0x12, 0x87, 0x59,
+	0xfe, 0x08, 0xe3, 0x30, 0x46, 0x20, 0x9f, 0x43, 0xc3, 0x3f, 0x11, 0xb7, 0xdc, 0x0f, 0xb4, 0x56,
+	0xf3, 0x97, 0x72, 0x14, 0x36, 0xfc, 0x13, 0x62, 0xc3, 0x34, 0x0d, 0x02, 0x3f, 0x10, 0x57, 0xdb,
+	0x8f, 0xb4, 0xd0, 0xee, 0xa0, 0xa4, 0x1c, 0x35, 0x0c, 0x8a, 0x7c, 0x01, 0xb3, 0x62, 0x67, 0x34,
+	0xd6, 0x4a, 0x67, 0xbb, 0xe8, 0xdf, 0x68, 0xe0, 0x0a, 0x16, 0x08, 0xe4, 0x31, 0x2c, 0xc7, 0x22,
+	0x21, 0x3f, 0x6f, 0x69, 0x4f, 0x5c, 0x69, 0x3f, 0xd3, 0x32, 0xf6, 0x20, 0x8b, 0x22, 0x69, 0xcb,
+	0xab, 0x30, 0x6f, 0xc0, 0x6a, 0xe1, 0xd6, 0x37, 0xa9, 0x66, 0x6e, 0xce, 0x03, 0xa4, 0x1e, 0x36,
+	0x5d, 0x58, 0x50, 0x3c, 0x84, 0x8c, 0x49, 0xd7, 0xef, 0xc5, 0x62, 0xec, 0x6f, 0xa4, 0x2f, 0x87,
+	0x34, 0x0c, 0x9d, 0x7e, 0xcc, 0x95, 0xc4, 0x4d, 0xcc, 0x3b, 0xbe, 0x77, 0xe9, 0x20, 0x66, 0x47,
+	0x78, 0x03, 0x7b, 0xf9, 0xed, 0x67, 0x8a, 0x93, 0x8e, 0xac, 0x61, 0x5e, 0x87, 0xf5, 0x33, 0xbf,
+	0x2f, 0x15, 0xa9, 0x4b, 0x22, 0xb7, 0x00, 0x4b, 0xc8, 0x7c, 0xc4, 0xce, 0xbf, 0x5e, 0x87, 0x0b,
+	0xb1, 0xfc, 0x31, 0xf7, 0x20, 0xa1, 0x30, 0x17, 0x9f, 0xcb, 0x44, 0xa3, 0xb8, 0x6c, 0xea, 0x1c,
+	0xf4, 0x56, 0x8d, 0x44, 0xb0, 0xa0, 0xa4, 0x14, 0x44, 0xb7, 0x38, 0x6e, 0x6a, 0x67, 0x2b, 0x56,
+	0x8d, 0xfc, 0x08, 0xf3, 0x72, 0xe0, 0x11, 0xcd, 0x6c, 0xd4, 0xd4, 0x8d, 0x68, 0xab, 0x46, 0x3c,
+	0x68, 0x4b, 0x29, 0x28, 0xd1, 0x4b, 0x55, 0xcd, 0xad, 0xaa, 0xc3, 0x13, 0x7d, 0x43, 0x58, 0x50,
+	0xa8, 0x0f, 0xa2, 0x4b, 0x92, 0x98, 0x55, 0x93, 0x17, 0xab, 0x76, 0xad, 0xce, 0x3d, 0x2a, 0x51,
+	0x1f, 0x9a, 0xd4, 0x89, 0xb9, 0x5d, 0x79, 0x7c, 0xf2, 0x85, 0x23, 0x58, 0x54, 0x33, 0x70, 0x52,
+	0xd5, 0x62, 0x73, 0x72, 0xc1, 0xaa, 0xf0, 0x9f, 0xfa, 0xd5, 0x36, 0xd9, 0x47, 0xca, 0x57, 0x41,
+	0xa2, 0x79, 0x67, 0x34, 0xb7, 0x2b, 0x8f, 0x97, 0xd7, 0x87, 0x72, 0x4d, 0x24, 0xba, 0x17, 0x4a,
+	0xf3, 0x5a, 0x75, 0x01, 0x29, 0x58, 0x71, 0x55, 0x4a, 0x84, 0x8e, 0x16, 0x1f, 0x64, 0xea, 0x65,
+	0xe7, 0x6c, 0x3d, 0x2e, 0xee, 0xa9, 0x44, 0x8f, 0x1e, 0x53, 0x64, 0x6a, 0x66, 0xef, 0x56, 0x8d,
+	0x7c, 0x07, 0xd3, 0x2c, 0x71, 0x26, 0x55, 0x39, 0x24, 0xb3, 0x72, 0x0e, 0xce, 0x37, 0x18, 0x99,
+	0x37, 0x22, 0x9a, 0x04, 0x93, 0xb9, 0x5d, 0x79, 0xbc, 0xbc, 0xc1, 0x48, 0xac, 0x12, 0xd1, 0x63,
+	0x9f, 0xcc, 0xad, 0xaa, 0xc3, 0x13, 0x7d, 0x4f, 0x60, 0x51, 0xe5, 0x9b, 0x88, 0x36, 0x35, 0x65,
+	0x5e, 0xd7, 0x90, 0x90, 0x7d, 0x2b, 0x5f, 0x21, 0x88, 0x26, 0xa1, 0x64, 0xea, 0xde, 0x4d, 0xb8,
+	0x6f, 0xa5, 0x9b, 0x06, 0xd1, 0x63, 0x9b, 0x4c, 0xcd, 0x0b, 0x8c, 0xd0, 0x27, 0x91, 0x49, 0x7a,
+	0x54, 0x94, 0xb9, 0x55, 0x75, 0xb8, 0x1a, 0xae, 0x12, 0xcf, 0xa4, 0xc9, 0x53, 0x99, 0xdb, 0x95,
+	0xc7, 0x27, 0x2a, 0x9f, 0xc1, 0x05, 0x21, 0x9d, 0x9c, 0x19, 0xfa, 0x24, 0x96, 0xb9, 0xa3, 0x23,
+	0x22, 0xeb, 0xce, 0xb0, 0x56, 0x44, 0x9f, 0xe1, 0x32, 0x77, 0x74, 0x44, 0x64, 0x57, 0xcb, 0xac,
+	0x16, 0xd1, 0xa4, 0xbf, 0xcc, 0xed, 0xca, 0xe3, 0xe5, 0x95, 0xaa, 0x12, 0x5e, 0x44, 0x9b, 0x1b,
+	0x33, 0xaf, 0x6b, 0x48, 0xc8, 0x61, 0x2c, 0xdd, 0xa9, 0x89, 0x1e, 0x71, 0x66, 0x6a, 0x5e, 0xd5,
+	0xad, 0x1a, 0xf9, 0x53, 0x1d, 0x56, 0x8a, 0x2a, 0xc2, 0xe4, 0x9c, 0x25, 0x64, 0xf3, 0x03, 0x6d,
+	0x39, 0x39, 0xc6, 0x32, 0x05, 0x00, 0xa2, 0xcf, 0xf0, 0x99, 0xe7, 0xa8, 0x2f, 0xf0, 0x09, 0x57,
+	0xeb, 0x04, 0x44, 0x9b, 0xfe, 0x33, 0xf5, 0x8b, 0x10, 0xf1, 0xa2, 0x56, 0x59, 0x3d, 0x7d, 0x6e,
+	0xd0, 0xdc, 0xd1, 0x11, 0x91, 0x83, 0x4d, 0x2a, 0x66, 0x10, 0x3d, 0xe2, 0xd0, 0xd4, 0xac, 0x91,
+	0x70, 0x7d, 0x12, 0x5b, 0x48, 0xf4, 0x58, 0x45, 0x73, 0xab, 0xea, 0xf0, 0x44, 0xdf, 0x09, 0x40,
+	0x5a, 0x63, 0x21, 0x5a, 0x94, 0xa3, 0xa9, 0x57, 0xb8, 0xe1, 0x11, 0xa4, 0x92, 0x8c, 0x44, 0x9b,
+	0x8f, 0x34, 0xaf, 0x6b, 0x48, 0x24, 0x8a, 0x7f, 0x57, 0x87, 0xe5, 0x1c, 0xff, 0x48, 0xce, 0xc3,
+	0x56, 0x9a, 0x37, 0xf5, 0x84, 0xa4, 0x6f, 0x27, 0x79, 0x6e, 0x92, 0x9c, 0x8b, 0xca, 0xd4, 0xbb,
+	0x43, 0x45, 0xb0, 0xa0, 0x30, 0x96, 0x44, 0x97, 0xdb, 0x34, 0xaf, 0x55, 0x17, 0x90, 0x0f, 0x24,
+	0xb9, 0xe4, 0x46, 0x34, 0x89, 0x4f, 0x53, 0xb7, 0x96, 0x67, 0xd5, 0x88, 0x0f, 0xcb, 0x69, 0xba,
+	0x1f, 0xff, 0x13, 0x10, 0xcd, 0x8c, 0xff, 0xad, 0xf2, 0xe1, 0x02, 0x59, 0x44, 0x55, 0xae, 0x0e,
+	0x48, 0xce, 0xc3, 0xc3, 0x9a, 0xe7, 0x2a, 0x35, 0x5a, 0x35, 0xf2, 0x1b, 0xfe, 0x70, 0x5a, 0x21,
+	0x57, 0xcf, 0x41, 0xd2, 0x9a, 0xe7, 0xa9, 0x47, 0x0a, 0x0f, 0xe4, 0x48, 0x59, 0x72, 0x1e, 0x0a,
+	0xd7, 0xbc, 0xa9, 0x27, 0x94, 0xc9, 0x06, 0x12, 0x12, 0x52, 0x8f, 0xc2, 0x34, 0x35, 0x0b, 0xa6,
+	0x56, 0x6d, 0xe7, 0x1f, 0x2d, 0x58, 0xc9, 0x54, 0xb5, 0x76, 0xf1, 0x85, 0x31, 0x79, 0x04, 0xad,
+	0xe4, 0xd5, 0x39, 0x79, 0xbb, 0xc4, 0x9f, 0xf2, 0x4b, 0x77, 0xf3, 0x9d, 0x6a, 0x83, 0x95, 0x33,
+	0x22, 0x7d, 0x3d, 0x3e, 0xf9, 0x8c, 0xc8, 0xbd, 0x4b, 0x37, 0xb7, 0xaa, 0x0e, 0x97, 0xcf, 0x88,
+	0xf4, 0x95, 0xf9, 0xc4, 0x33, 0x22, 0xf7, 0x7a, 0xdd, 0x7c, 0xb7, 0xe2, 0x68, 0xa5, 0xa2, 0x95,
+	0x3e, 0x3b, 0x2f, 0x59, 0xbf, 0xd9, 0x47, 0xee, 0xe6, 0x56, 0xd5, 0xe1, 0x89, 0xbe, 0x80, 0xed,
+	0x1a, 0xf8, 0xc3, 0xcb, 0x2b, 0x4c, 0x8c, 0x81, 0xa8, 0x3a, 0x5f, 0x4e, 0x71, 0xe2, 0xf7, 0xf5,
+	0xf8, 0x1f, 0xc3, 0x2a, 0x2f, 0xf8, 0xcb, 0xf7, 0x9e, 0x82, 0x87, 0xfa, 0xe6, 0x7b, 0x9a, 0x52,
+	0xca, 0x9e, 0x91, 0x7b, 0x48, 0x4f, 0xca, 0x36, 0xa0, 0xa2, 0x27, 0xf9, 0xe6, 0x4d, 0x3d, 0xa1,
+	0xc4, 0x84, 0xa7, 0xcc, 0xff, 0xd9, 0x37, 0xf6, 0x37, 0x27, 0xef, 0x05, 0xc5, 0xaf, 0xdd, 0x4d,
+	0x8d, 0x17, 0xec, 0x62, 0x0a, 0x8e, 0xf4, 0x54, 0x9f, 0xf9, 0xd0, 0xde, 0x7c, 0x4f, 0x53, 0x2a,
+	0xfe, 0xfe, 0x5b, 0x6f, 0x02, 0xf1, 0x83, 0x7e, 0x2c, 0x2a, 0x44, 0xbe, 0x59, 0xde, 0xfa, 0x38,
+	0x83, 0xf2, 0x70, 0x86, 0xfd, 0x6f, 0x72, 0x37, 0xfe, 0x3d, 0x00, 0xc0, 0xcc, 0x48, 0xd2, 0x66,
+	0x4e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// LocationTrackerClient is the client API for LocationTracker service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LocationTrackerClient interface {
+	Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
+	GetTrackables(ctx context.Context, in *GetTrackablesRequest, opts ...grpc.CallOption) (*GetTrackablesResponse, error)
+	StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error)
+	StopSession(ctx context.Context, in *StopSessionRequest, opts ...grpc.CallOption) (*StopSessionResponse, error)
+	StartTracking(ctx context.Context, in *StartTrackingRequest, opts ...grpc.CallOption) (LocationTracker_StartTrackingClient, error)
+	StopTracking(ctx context.Context, in *StopTrackingRequest, opts ...grpc.CallOption) (*StopTrackingResponse, error)
+	ReportLocation(ctx context.Context, opts ...grpc.CallOption) (LocationTracker_ReportLocationClient, error)
+	PauseSharing(ctx context.Context, in *PauseSharingRequest, opts ...grpc.CallOption) (*PauseSharingResponse, error)
+	ResumeSharing(ctx context.Context, in *ResumeSharingRequest, opts ...grpc.CallOption) (*ResumeSharingResponse, error)
+	GetSessionIds(ctx context.Context, in *SessionIdsRequest, opts ...grpc.CallOption) (*SessionIdsResponse, error)
+	GetSessionData(ctx context.Context, in *SessionDataRequest, opts ...grpc.CallOption) (*SessionDataResponse, error)
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error)
+	RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error)
+	LabelApiKey(ctx context.Context, in *LabelApiKeyRequest, opts ...grpc.CallOption) (*LabelApiKeyResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	RequestTracking(ctx context.Context, in *RequestTrackingRequest, opts ...grpc.CallOption) (*RequestTrackingResponse, error)
+	ApproveTracking(ctx context.Context, in *ApproveTrackingRequest, opts ...grpc.CallOption) (*ApproveTrackingResponse, error)
+	DenyTracking(ctx context.Context, in *DenyTrackingRequest, opts ...grpc.CallOption) (*DenyTrackingResponse, error)
+	RevokeTracking(ctx context.Context, in *RevokeTrackingRequest, opts ...grpc.CallOption) (*RevokeTrackingResponse, error)
+	GetWatchers(ctx context.Context, in *GetWatchersRequest, opts ...grpc.CallOption) (*GetWatchersResponse, error)
+	SetTrackingPrecision(ctx context.Context, in *SetTrackingPrecisionRequest, opts ...grpc.CallOption) (*SetTrackingPrecisionResponse, error)
+	CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error)
+	ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error)
+	RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error)
+	CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error)
+	DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error)
+	ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error)
+	AddGroupMember(ctx context.Context, in *AddGroupMemberRequest, opts ...grpc.CallOption) (*AddGroupMemberResponse, error)
+	RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*RemoveGroupMemberResponse, error)
+	StartGroupTracking(ctx context.Context, in *StartGroupTrackingRequest, opts ...grpc.CallOption) (LocationTracker_StartGroupTrackingClient, error)
+	DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error)
+	ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataResponse, error)
+	GetSessionSummary(ctx context.Context, in *SessionDataRequest, opts ...grpc.CallOption) (*SessionSummary, error)
+	CreatePrivacyZone(ctx context.Context, in *CreatePrivacyZoneRequest, opts ...grpc.CallOption) (*CreatePrivacyZoneResponse, error)
+	ListPrivacyZones(ctx context.Context, in *ListPrivacyZonesRequest, opts ...grpc.CallOption) (*ListPrivacyZonesResponse, error)
+	DeletePrivacyZone(ctx context.Context, in *DeletePrivacyZoneRequest, opts ...grpc.CallOption) (*DeletePrivacyZoneResponse, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+}
+
+type locationTrackerClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewLocationTrackerClient(cc *grpc.ClientConn) LocationTrackerClient {
+	return &locationTrackerClient{cc}
+}
+
+func (c *locationTrackerClient) Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error) {
+	out := new(RegisterResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/Register", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) GetTrackables(ctx context.Context, in *GetTrackablesRequest, opts ...grpc.CallOption) (*GetTrackablesResponse, error) {
+	out := new(GetTrackablesResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetTrackables", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) StartSession(ctx context.Context, in *StartSessionRequest, opts ...grpc.CallOption) (*StartSessionResponse, error) {
+	out := new(StartSessionResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/StartSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) StopSession(ctx context.Context, in *StopSessionRequest, opts ...grpc.CallOption) (*StopSessionResponse, error) {
+	out := new(StopSessionResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/StopSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) StartTracking(ctx context.Context, in *StartTrackingRequest, opts ...grpc.CallOption) (LocationTracker_StartTrackingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LocationTracker_serviceDesc.Streams[0], "/pb.potpie.locationtracker.LocationTracker/StartTracking", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &locationTrackerStartTrackingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LocationTracker_StartTrackingClient interface {
+	Recv() (*TrackingData, error)
+	grpc.ClientStream
+}
+
+type locationTrackerStartTrackingClient struct {
+	grpc.ClientStream
+}
+
+func (x *locationTrackerStartTrackingClient) Recv() (*TrackingData, error) {
+	m := new(TrackingData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *locationTrackerClient) StopTracking(ctx context.Context, in *StopTrackingRequest, opts ...grpc.CallOption) (*StopTrackingResponse, error) {
+	out := new(StopTrackingResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/StopTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ReportLocation(ctx context.Context, opts ...grpc.CallOption) (LocationTracker_ReportLocationClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LocationTracker_serviceDesc.Streams[1], "/pb.potpie.locationtracker.LocationTracker/ReportLocation", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &locationTrackerReportLocationClient{stream}
+	return x, nil
+}
+
+type LocationTracker_ReportLocationClient interface {
+	Send(*TrackingData) error
+	CloseAndRecv() (*ReportLocationResponse, error)
+	grpc.ClientStream
+}
+
+type locationTrackerReportLocationClient struct {
+	grpc.ClientStream
+}
+
+func (x *locationTrackerReportLocationClient) Send(m *TrackingData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *locationTrackerReportLocationClient) CloseAndRecv() (*ReportLocationResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ReportLocationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *locationTrackerClient) PauseSharing(ctx context.Context, in *PauseSharingRequest, opts ...grpc.CallOption) (*PauseSharingResponse, error) {
+	out := new(PauseSharingResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/PauseSharing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ResumeSharing(ctx context.Context, in *ResumeSharingRequest, opts ...grpc.CallOption) (*ResumeSharingResponse, error) {
+	out := new(ResumeSharingResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/ResumeSharing", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) GetSessionIds(ctx context.Context, in *SessionIdsRequest, opts ...grpc.CallOption) (*SessionIdsResponse, error) {
+	out := new(SessionIdsResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetSessionIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) GetSessionData(ctx context.Context, in *SessionDataRequest, opts ...grpc.CallOption) (*SessionDataResponse, error) {
+	out := new(SessionDataResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetSessionData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...grpc.CallOption) (*RefreshTokenResponse, error) {
+	out := new(RefreshTokenResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/RefreshToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...grpc.CallOption) (*RevokeTokenResponse, error) {
+	out := new(RevokeTokenResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/RevokeToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ListApiKeys(ctx context.Context, in *ListApiKeysRequest, opts ...grpc.CallOption) (*ListApiKeysResponse, error) {
+	out := new(ListApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/ListApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) LabelApiKey(ctx context.Context, in *LabelApiKeyRequest, opts ...grpc.CallOption) (*LabelApiKeyResponse, error) {
+	out := new(LabelApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/LabelApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/RevokeApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) RequestTracking(ctx context.Context, in *RequestTrackingRequest, opts ...grpc.CallOption) (*RequestTrackingResponse, error) {
+	out := new(RequestTrackingResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/RequestTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ApproveTracking(ctx context.Context, in *ApproveTrackingRequest, opts ...grpc.CallOption) (*ApproveTrackingResponse, error) {
+	out := new(ApproveTrackingResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/ApproveTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) DenyTracking(ctx context.Context, in *DenyTrackingRequest, opts ...grpc.CallOption) (*DenyTrackingResponse, error) {
+	out := new(DenyTrackingResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/DenyTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) RevokeTracking(ctx context.Context, in *RevokeTrackingRequest, opts ...grpc.CallOption) (*RevokeTrackingResponse, error) {
+	out := new(RevokeTrackingResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/RevokeTracking", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) GetWatchers(ctx context.Context, in *GetWatchersRequest, opts ...grpc.CallOption) (*GetWatchersResponse, error) {
+	out := new(GetWatchersResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetWatchers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) SetTrackingPrecision(ctx context.Context, in *SetTrackingPrecisionRequest, opts ...grpc.CallOption) (*SetTrackingPrecisionResponse, error) {
+	out := new(SetTrackingPrecisionResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/SetTrackingPrecision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) CreateShareLink(ctx context.Context, in *CreateShareLinkRequest, opts ...grpc.CallOption) (*CreateShareLinkResponse, error) {
+	out := new(CreateShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/CreateShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ListShareLinks(ctx context.Context, in *ListShareLinksRequest, opts ...grpc.CallOption) (*ListShareLinksResponse, error) {
+	out := new(ListShareLinksResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/ListShareLinks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) RevokeShareLink(ctx context.Context, in *RevokeShareLinkRequest, opts ...grpc.CallOption) (*RevokeShareLinkResponse, error) {
+	out := new(RevokeShareLinkResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/RevokeShareLink", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) CreateGroup(ctx context.Context, in *CreateGroupRequest, opts ...grpc.CallOption) (*CreateGroupResponse, error) {
+	out := new(CreateGroupResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/CreateGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) DeleteGroup(ctx context.Context, in *DeleteGroupRequest, opts ...grpc.CallOption) (*DeleteGroupResponse, error) {
+	out := new(DeleteGroupResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/DeleteGroup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ListGroups(ctx context.Context, in *ListGroupsRequest, opts ...grpc.CallOption) (*ListGroupsResponse, error) {
+	out := new(ListGroupsResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/ListGroups", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) AddGroupMember(ctx context.Context, in *AddGroupMemberRequest, opts ...grpc.CallOption) (*AddGroupMemberResponse, error) {
+	out := new(AddGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/AddGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) RemoveGroupMember(ctx context.Context, in *RemoveGroupMemberRequest, opts ...grpc.CallOption) (*RemoveGroupMemberResponse, error) {
+	out := new(RemoveGroupMemberResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/RemoveGroupMember", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) StartGroupTracking(ctx context.Context, in *StartGroupTrackingRequest, opts ...grpc.CallOption) (LocationTracker_StartGroupTrackingClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_LocationTracker_serviceDesc.Streams[2], "/pb.potpie.locationtracker.LocationTracker/StartGroupTracking", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &locationTrackerStartGroupTrackingClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type LocationTracker_StartGroupTrackingClient interface {
+	Recv() (*TrackingData, error)
+	grpc.ClientStream
+}
+
+type locationTrackerStartGroupTrackingClient struct {
+	grpc.ClientStream
+}
+
+func (x *locationTrackerStartGroupTrackingClient) Recv() (*TrackingData, error) {
+	m := new(TrackingData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *locationTrackerClient) DeleteAccount(ctx context.Context, in *DeleteAccountRequest, opts ...grpc.CallOption) (*DeleteAccountResponse, error) {
+	out := new(DeleteAccountResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/DeleteAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ExportMyData(ctx context.Context, in *ExportMyDataRequest, opts ...grpc.CallOption) (*ExportMyDataResponse, error) {
+	out := new(ExportMyDataResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/ExportMyData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) GetSessionSummary(ctx context.Context, in *SessionDataRequest, opts ...grpc.CallOption) (*SessionSummary, error) {
+	out := new(SessionSummary)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetSessionSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) CreatePrivacyZone(ctx context.Context, in *CreatePrivacyZoneRequest, opts ...grpc.CallOption) (*CreatePrivacyZoneResponse, error) {
+	out := new(CreatePrivacyZoneResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/CreatePrivacyZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) ListPrivacyZones(ctx context.Context, in *ListPrivacyZonesRequest, opts ...grpc.CallOption) (*ListPrivacyZonesResponse, error) {
+	out := new(ListPrivacyZonesResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/ListPrivacyZones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) DeletePrivacyZone(ctx context.Context, in *DeletePrivacyZoneRequest, opts ...grpc.CallOption) (*DeletePrivacyZoneResponse, error) {
+	out := new(DeletePrivacyZoneResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/DeletePrivacyZone", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *locationTrackerClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LocationTrackerServer is the server API for LocationTracker service.
+type LocationTrackerServer interface {
+	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
+	GetTrackables(context.Context, *GetTrackablesRequest) (*GetTrackablesResponse, error)
+	StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error)
+	StopSession(context.Context, *StopSessionRequest) (*StopSessionResponse, error)
+	StartTracking(*StartTrackingRequest, LocationTracker_StartTrackingServer) error
+	StopTracking(context.Context, *StopTrackingRequest) (*StopTrackingResponse, error)
+	ReportLocation(LocationTracker_ReportLocationServer) error
+	PauseSharing(context.Context, *PauseSharingRequest) (*PauseSharingResponse, error)
+	ResumeSharing(context.Context, *ResumeSharingRequest) (*ResumeSharingResponse, error)
+	GetSessionIds(context.Context, *SessionIdsRequest) (*SessionIdsResponse, error)
+	GetSessionData(context.Context, *SessionDataRequest) (*SessionDataResponse, error)
+	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	RefreshToken(context.Context, *RefreshTokenRequest) (*RefreshTokenResponse, error)
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	ListApiKeys(context.Context, *ListApiKeysRequest) (*ListApiKeysResponse, error)
+	LabelApiKey(context.Context, *LabelApiKeyRequest) (*LabelApiKeyResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	RequestTracking(context.Context, *RequestTrackingRequest) (*RequestTrackingResponse, error)
+	ApproveTracking(context.Context, *ApproveTrackingRequest) (*ApproveTrackingResponse, error)
+	DenyTracking(context.Context, *DenyTrackingRequest) (*DenyTrackingResponse, error)
+	RevokeTracking(context.Context, *RevokeTrackingRequest) (*RevokeTrackingResponse, error)
+	GetWatchers(context.Context, *GetWatchersRequest) (*GetWatchersResponse, error)
+	SetTrackingPrecision(context.Context, *SetTrackingPrecisionRequest) (*SetTrackingPrecisionResponse, error)
+	CreateShareLink(context.Context, *CreateShareLinkRequest) (*CreateShareLinkResponse, error)
+	ListShareLinks(context.Context, *ListShareLinksRequest) (*ListShareLinksResponse, error)
+	RevokeShareLink(context.Context, *RevokeShareLinkRequest) (*RevokeShareLinkResponse, error)
+	CreateGroup(context.Context, *CreateGroupRequest) (*CreateGroupResponse, error)
+	DeleteGroup(context.Context, *DeleteGroupRequest) (*DeleteGroupResponse, error)
+	ListGroups(context.Context, *ListGroupsRequest) (*ListGroupsResponse, error)
+	AddGroupMember(context.Context, *AddGroupMemberRequest) (*AddGroupMemberResponse, error)
+	RemoveGroupMember(context.Context, *RemoveGroupMemberRequest) (*RemoveGroupMemberResponse, error)
+	StartGroupTracking(*StartGroupTrackingRequest, LocationTracker_StartGroupTrackingServer) error
+	DeleteAccount(context.Context, *DeleteAccountRequest) (*DeleteAccountResponse, error)
+	ExportMyData(context.Context, *ExportMyDataRequest) (*ExportMyDataResponse, error)
+	GetSessionSummary(context.Context, *SessionDataRequest) (*SessionSummary, error)
+	CreatePrivacyZone(context.Context, *CreatePrivacyZoneRequest) (*CreatePrivacyZoneResponse, error)
+	ListPrivacyZones(context.Context, *ListPrivacyZonesRequest) (*ListPrivacyZonesResponse, error)
+	DeletePrivacyZone(context.Context, *DeletePrivacyZoneRequest) (*DeletePrivacyZoneResponse, error)
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+}
+
+func RegisterLocationTrackerServer(s *grpc.Server, srv LocationTrackerServer) {
+	s.RegisterService(&_LocationTracker_serviceDesc, srv)
+}
+
+func _LocationTracker_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/Register",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_GetTrackables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrackablesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).GetTrackables(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetTrackables",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).GetTrackables(ctx, req.(*GetTrackablesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_StartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StartSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).StartSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/StartSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).StartSession(ctx, req.(*StartSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_StopSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopSessionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).StopSession(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/StopSession",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).StopSession(ctx, req.(*StopSessionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_StartTracking_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StartTrackingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LocationTrackerServer).StartTracking(m, &locationTrackerStartTrackingServer{stream})
+}
+
+type LocationTracker_StartTrackingServer interface {
+	Send(*TrackingData) error
+	grpc.ServerStream
+}
+
+type locationTrackerStartTrackingServer struct {
+	grpc.ServerStream
+}
+
+func (x *locationTrackerStartTrackingServer) Send(m *TrackingData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LocationTracker_StopTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StopTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).StopTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/StopTracking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).StopTracking(ctx, req.(*StopTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ReportLocation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(LocationTrackerServer).ReportLocation(&locationTrackerReportLocationServer{stream})
+}
+
+type LocationTracker_ReportLocationServer interface {
+	SendAndClose(*ReportLocationResponse) error
+	Recv() (*TrackingData, error)
+	grpc.ServerStream
+}
+
+type locationTrackerReportLocationServer struct {
+	grpc.ServerStream
+}
+
+func (x *locationTrackerReportLocationServer) SendAndClose(m *ReportLocationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *locationTrackerReportLocationServer) Recv() (*TrackingData, error) {
+	m := new(TrackingData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _LocationTracker_PauseSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PauseSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).PauseSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/PauseSharing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).PauseSharing(ctx, req.(*PauseSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ResumeSharing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResumeSharingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).ResumeSharing(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/ResumeSharing",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).ResumeSharing(ctx, req.(*ResumeSharingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_GetSessionIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).GetSessionIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetSessionIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).GetSessionIds(ctx, req.(*SessionIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_GetSessionData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).GetSessionData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetSessionData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).GetSessionData(ctx, req.(*SessionDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_RefreshToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefreshTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).RefreshToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/RefreshToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).RefreshToken(ctx, req.(*RefreshTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_RevokeToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).RevokeToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/RevokeToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).RevokeToken(ctx, req.(*RevokeTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).CreateApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/CreateApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ListApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApiKeysRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).ListApiKeys(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/ListApiKeys",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).ListApiKeys(ctx, req.(*ListApiKeysRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_LabelApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LabelApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).LabelApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/LabelApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).LabelApiKey(ctx, req.(*LabelApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).RevokeApiKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/RevokeApiKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_RequestTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).RequestTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/RequestTracking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).RequestTracking(ctx, req.(*RequestTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ApproveTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).ApproveTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/ApproveTracking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).ApproveTracking(ctx, req.(*ApproveTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_DenyTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DenyTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).DenyTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/DenyTracking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).DenyTracking(ctx, req.(*DenyTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_RevokeTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTrackingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).RevokeTracking(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/RevokeTracking",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).RevokeTracking(ctx, req.(*RevokeTrackingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_GetWatchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWatchersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).GetWatchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetWatchers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).GetWatchers(ctx, req.(*GetWatchersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_SetTrackingPrecision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTrackingPrecisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).SetTrackingPrecision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/SetTrackingPrecision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).SetTrackingPrecision(ctx, req.(*SetTrackingPrecisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_CreateShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).CreateShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/CreateShareLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).CreateShareLink(ctx, req.(*CreateShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ListShareLinks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListShareLinksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).ListShareLinks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/ListShareLinks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).ListShareLinks(ctx, req.(*ListShareLinksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_RevokeShareLink_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeShareLinkRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).RevokeShareLink(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/RevokeShareLink",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).RevokeShareLink(ctx, req.(*RevokeShareLinkRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_CreateGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).CreateGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/CreateGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).CreateGroup(ctx, req.(*CreateGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_DeleteGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).DeleteGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/DeleteGroup",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).DeleteGroup(ctx, req.(*DeleteGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ListGroups_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGroupsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).ListGroups(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/ListGroups",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).ListGroups(ctx, req.(*ListGroupsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_AddGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).AddGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/AddGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).AddGroupMember(ctx, req.(*AddGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_RemoveGroupMember_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveGroupMemberRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).RemoveGroupMember(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/RemoveGroupMember",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).RemoveGroupMember(ctx, req.(*RemoveGroupMemberRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_StartGroupTracking_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(StartGroupTrackingRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(LocationTrackerServer).StartGroupTracking(m, &locationTrackerStartGroupTrackingServer{stream})
+}
+
+type LocationTracker_StartGroupTrackingServer interface {
+	Send(*TrackingData) error
+	grpc.ServerStream
+}
+
+type locationTrackerStartGroupTrackingServer struct {
+	grpc.ServerStream
+}
+
+func (x *locationTrackerStartGroupTrackingServer) Send(m *TrackingData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _LocationTracker_DeleteAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).DeleteAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/DeleteAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).DeleteAccount(ctx, req.(*DeleteAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ExportMyData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMyDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).ExportMyData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/ExportMyData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).ExportMyData(ctx, req.(*ExportMyDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_GetSessionSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).GetSessionSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetSessionSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).GetSessionSummary(ctx, req.(*SessionDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_CreatePrivacyZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePrivacyZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).CreatePrivacyZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/CreatePrivacyZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).CreatePrivacyZone(ctx, req.(*CreatePrivacyZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_ListPrivacyZones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPrivacyZonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).ListPrivacyZones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/ListPrivacyZones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).ListPrivacyZones(ctx, req.(*ListPrivacyZonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_DeletePrivacyZone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePrivacyZoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).DeletePrivacyZone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/DeletePrivacyZone",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).DeletePrivacyZone(ctx, req.(*DeletePrivacyZoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LocationTracker_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerServer).GetAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _LocationTracker_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.potpie.locationtracker.LocationTracker",
+	HandlerType: (*LocationTrackerServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Register",
+			Handler:    _LocationTracker_Register_Handler,
+		},
+		{
+			MethodName: "GetTrackables",
+			Handler:    _LocationTracker_GetTrackables_Handler,
+		},
+		{
+			MethodName: "StartSession",
+			Handler:    _LocationTracker_StartSession_Handler,
+		},
+		{
+			MethodName: "StopSession",
+			Handler:    _LocationTracker_StopSession_Handler,
+		},
+		{
+			MethodName: "StopTracking",
+			Handler:    _LocationTracker_StopTracking_Handler,
+		},
+		{
+			MethodName: "PauseSharing",
+			Handler:    _LocationTracker_PauseSharing_Handler,
+		},
+		{
+			MethodName: "ResumeSharing",
+			Handler:    _LocationTracker_ResumeSharing_Handler,
+		},
+		{
+			MethodName: "GetSessionIds",
+			Handler:    _LocationTracker_GetSessionIds_Handler,
+		},
+		{
+			MethodName: "GetSessionData",
+			Handler:    _LocationTracker_GetSessionData_Handler,
+		},
+		{
+			MethodName: "Login",
+			Handler:    _LocationTracker_Login_Handler,
+		},
+		{
+			MethodName: "RefreshToken",
+			Handler:    _LocationTracker_RefreshToken_Handler,
+		},
+		{
+			MethodName: "RevokeToken",
+			Handler:    _LocationTracker_RevokeToken_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _LocationTracker_ChangePassword_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _LocationTracker_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "ListApiKeys",
+			Handler:    _LocationTracker_ListApiKeys_Handler,
+		},
+		{
+			MethodName: "LabelApiKey",
+			Handler:    _LocationTracker_LabelApiKey_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _LocationTracker_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "RequestTracking",
+			Handler:    _LocationTracker_RequestTracking_Handler,
+		},
+		{
+			MethodName: "ApproveTracking",
+			Handler:    _LocationTracker_ApproveTracking_Handler,
+		},
+		{
+			MethodName: "DenyTracking",
+			Handler:    _LocationTracker_DenyTracking_Handler,
+		},
+		{
+			MethodName: "RevokeTracking",
+			Handler:    _LocationTracker_RevokeTracking_Handler,
+		},
+		{
+			MethodName: "GetWatchers",
+			Handler:    _LocationTracker_GetWatchers_Handler,
+		},
+		{
+			MethodName: "SetTrackingPrecision",
+			Handler:    _LocationTracker_SetTrackingPrecision_Handler,
+		},
+		{
+			MethodName: "CreateShareLink",
+			Handler:    _LocationTracker_CreateShareLink_Handler,
+		},
+		{
+			MethodName: "ListShareLinks",
+			Handler:    _LocationTracker_ListShareLinks_Handler,
+		},
+		{
+			MethodName: "RevokeShareLink",
+			Handler:    _LocationTracker_RevokeShareLink_Handler,
+		},
+		{
+			MethodName: "CreateGroup",
+			Handler:    _LocationTracker_CreateGroup_Handler,
+		},
+		{
+			MethodName: "DeleteGroup",
+			Handler:    _LocationTracker_DeleteGroup_Handler,
+		},
+		{
+			MethodName: "ListGroups",
+			Handler:    _LocationTracker_ListGroups_Handler,
+		},
+		{
+			MethodName: "AddGroupMember",
+			Handler:    _LocationTracker_AddGroupMember_Handler,
+		},
+		{
+			MethodName: "RemoveGroupMember",
+			Handler:    _LocationTracker_RemoveGroupMember_Handler,
+		},
+		{
+			MethodName: "DeleteAccount",
+			Handler:    _LocationTracker_DeleteAccount_Handler,
+		},
+		{
+			MethodName: "ExportMyData",
+			Handler:    _LocationTracker_ExportMyData_Handler,
+		},
+		{
+			MethodName: "GetSessionSummary",
+			Handler:    _LocationTracker_GetSessionSummary_Handler,
+		},
+		{
+			MethodName: "CreatePrivacyZone",
+			Handler:    _LocationTracker_CreatePrivacyZone_Handler,
+		},
+		{
+			MethodName: "ListPrivacyZones",
+			Handler:    _LocationTracker_ListPrivacyZones_Handler,
+		},
+		{
+			MethodName: "DeletePrivacyZone",
+			Handler:    _LocationTracker_DeletePrivacyZone_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _LocationTracker_GetAuditLog_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StartTracking",
+			Handler:       _LocationTracker_StartTracking_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ReportLocation",
+			Handler:       _LocationTracker_ReportLocation_Handler,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "StartGroupTracking",
+			Handler:       _LocationTracker_StartGroupTracking_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "locationtracker.proto",
 }
 
-type locationTrackerStartTrackingClient struct {
-	grpc.ClientStream
+// LocationTrackerAdminClient is the client API for LocationTrackerAdmin service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type LocationTrackerAdminClient interface {
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error)
+	DisableUser(ctx context.Context, in *DisableUserRequest, opts ...grpc.CallOption) (*DisableUserResponse, error)
+	EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error)
+	SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*SetUserRoleResponse, error)
+	GetUserSessionIds(ctx context.Context, in *SessionIdsRequest, opts ...grpc.CallOption) (*SessionIdsResponse, error)
+	GetUserSessionData(ctx context.Context, in *SessionDataRequest, opts ...grpc.CallOption) (*SessionDataResponse, error)
+	CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error)
+	ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error)
+	GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error)
+	SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error)
+}
+
+type locationTrackerAdminClient struct {
+	cc *grpc.ClientConn
 }
 
-func (x *locationTrackerStartTrackingClient) Recv() (*TrackingData, error) {
-	m := new(TrackingData)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+func NewLocationTrackerAdminClient(cc *grpc.ClientConn) LocationTrackerAdminClient {
+	return &locationTrackerAdminClient{cc}
+}
+
+func (c *locationTrackerAdminClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersResponse, error) {
+	out := new(ListUsersResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/ListUsers", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *locationTrackerClient) StopTracking(ctx context.Context, in *StopTrackingRequest, opts ...grpc.CallOption) (*StopTrackingResponse, error) {
-	out := new(StopTrackingResponse)
-	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/StopTracking", in, out, opts...)
+func (c *locationTrackerAdminClient) DisableUser(ctx context.Context, in *DisableUserRequest, opts ...grpc.CallOption) (*DisableUserResponse, error) {
+	out := new(DisableUserResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/DisableUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *locationTrackerClient) ReportLocation(ctx context.Context, opts ...grpc.CallOption) (LocationTracker_ReportLocationClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_LocationTracker_serviceDesc.Streams[1], "/pb.potpie.locationtracker.LocationTracker/ReportLocation", opts...)
+func (c *locationTrackerAdminClient) EnableUser(ctx context.Context, in *EnableUserRequest, opts ...grpc.CallOption) (*EnableUserResponse, error) {
+	out := new(EnableUserResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/EnableUser", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	x := &locationTrackerReportLocationClient{stream}
-	return x, nil
+	return out, nil
 }
 
-type LocationTracker_ReportLocationClient interface {
-	Send(*TrackingData) error
-	CloseAndRecv() (*ReportLocationResponse, error)
-	grpc.ClientStream
+func (c *locationTrackerAdminClient) SetUserRole(ctx context.Context, in *SetUserRoleRequest, opts ...grpc.CallOption) (*SetUserRoleResponse, error) {
+	out := new(SetUserRoleResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/SetUserRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-type locationTrackerReportLocationClient struct {
-	grpc.ClientStream
+func (c *locationTrackerAdminClient) GetUserSessionIds(ctx context.Context, in *SessionIdsRequest, opts ...grpc.CallOption) (*SessionIdsResponse, error) {
+	out := new(SessionIdsResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/GetUserSessionIds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *locationTrackerReportLocationClient) Send(m *TrackingData) error {
-	return x.ClientStream.SendMsg(m)
+func (c *locationTrackerAdminClient) GetUserSessionData(ctx context.Context, in *SessionDataRequest, opts ...grpc.CallOption) (*SessionDataResponse, error) {
+	out := new(SessionDataResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/GetUserSessionData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (x *locationTrackerReportLocationClient) CloseAndRecv() (*ReportLocationResponse, error) {
-	if err := x.ClientStream.CloseSend(); err != nil {
+func (c *locationTrackerAdminClient) CreateOrganization(ctx context.Context, in *CreateOrganizationRequest, opts ...grpc.CallOption) (*CreateOrganizationResponse, error) {
+	out := new(CreateOrganizationResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/CreateOrganization", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	m := new(ReportLocationResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
+	return out, nil
+}
+
+func (c *locationTrackerAdminClient) ListOrganizations(ctx context.Context, in *ListOrganizationsRequest, opts ...grpc.CallOption) (*ListOrganizationsResponse, error) {
+	out := new(ListOrganizationsResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/ListOrganizations", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	return m, nil
+	return out, nil
 }
 
-func (c *locationTrackerClient) GetSessionIds(ctx context.Context, in *SessionIdsRequest, opts ...grpc.CallOption) (*SessionIdsResponse, error) {
-	out := new(SessionIdsResponse)
-	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetSessionIds", in, out, opts...)
+func (c *locationTrackerAdminClient) GetRetentionPolicy(ctx context.Context, in *GetRetentionPolicyRequest, opts ...grpc.CallOption) (*RetentionPolicy, error) {
+	out := new(RetentionPolicy)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/GetRetentionPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *locationTrackerClient) GetSessionData(ctx context.Context, in *SessionDataRequest, opts ...grpc.CallOption) (*SessionDataResponse, error) {
-	out := new(SessionDataResponse)
-	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTracker/GetSessionData", in, out, opts...)
+func (c *locationTrackerAdminClient) SetRetentionPolicy(ctx context.Context, in *SetRetentionPolicyRequest, opts ...grpc.CallOption) (*SetRetentionPolicyResponse, error) {
+	out := new(SetRetentionPolicyResponse)
+	err := c.cc.Invoke(ctx, "/pb.potpie.locationtracker.LocationTrackerAdmin/SetRetentionPolicy", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-// LocationTrackerServer is the server API for LocationTracker service.
-type LocationTrackerServer interface {
-	Register(context.Context, *RegisterRequest) (*RegisterResponse, error)
-	GetTrackables(context.Context, *GetTrackablesRequest) (*GetTrackablesResponse, error)
-	StartSession(context.Context, *StartSessionRequest) (*StartSessionResponse, error)
-	StopSession(context.Context, *StopSessionRequest) (*StopSessionResponse, error)
-	StartTracking(*StartTrackingRequest, LocationTracker_StartTrackingServer) error
-	StopTracking(context.Context, *StopTrackingRequest) (*StopTrackingResponse, error)
-	ReportLocation(LocationTracker_ReportLocationServer) error
-	GetSessionIds(context.Context, *SessionIdsRequest) (*SessionIdsResponse, error)
-	GetSessionData(context.Context, *SessionDataRequest) (*SessionDataResponse, error)
+// LocationTrackerAdminServer is the server API for LocationTrackerAdmin service.
+type LocationTrackerAdminServer interface {
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersResponse, error)
+	DisableUser(context.Context, *DisableUserRequest) (*DisableUserResponse, error)
+	EnableUser(context.Context, *EnableUserRequest) (*EnableUserResponse, error)
+	SetUserRole(context.Context, *SetUserRoleRequest) (*SetUserRoleResponse, error)
+	GetUserSessionIds(context.Context, *SessionIdsRequest) (*SessionIdsResponse, error)
+	GetUserSessionData(context.Context, *SessionDataRequest) (*SessionDataResponse, error)
+	CreateOrganization(context.Context, *CreateOrganizationRequest) (*CreateOrganizationResponse, error)
+	ListOrganizations(context.Context, *ListOrganizationsRequest) (*ListOrganizationsResponse, error)
+	GetRetentionPolicy(context.Context, *GetRetentionPolicyRequest) (*RetentionPolicy, error)
+	SetRetentionPolicy(context.Context, *SetRetentionPolicyRequest) (*SetRetentionPolicyResponse, error)
 }
 
-func RegisterLocationTrackerServer(s *grpc.Server, srv LocationTrackerServer) {
-	s.RegisterService(&_LocationTracker_serviceDesc, srv)
+func RegisterLocationTrackerAdminServer(s *grpc.Server, srv LocationTrackerAdminServer) {
+	s.RegisterService(&_LocationTrackerAdmin_serviceDesc, srv)
 }
 
-func _LocationTracker_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _LocationTrackerAdmin_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocationTrackerServer).Register(ctx, in)
+		return srv.(LocationTrackerAdminServer).ListUsers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.potpie.locationtracker.LocationTracker/Register",
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/ListUsers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocationTrackerServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(LocationTrackerAdminServer).ListUsers(ctx, req.(*ListUsersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocationTracker_GetTrackables_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTrackablesRequest)
+func _LocationTrackerAdmin_DisableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisableUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocationTrackerServer).GetTrackables(ctx, in)
+		return srv.(LocationTrackerAdminServer).DisableUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetTrackables",
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/DisableUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocationTrackerServer).GetTrackables(ctx, req.(*GetTrackablesRequest))
+		return srv.(LocationTrackerAdminServer).DisableUser(ctx, req.(*DisableUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocationTracker_StartSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StartSessionRequest)
+func _LocationTrackerAdmin_EnableUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EnableUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocationTrackerServer).StartSession(ctx, in)
+		return srv.(LocationTrackerAdminServer).EnableUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.potpie.locationtracker.LocationTracker/StartSession",
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/EnableUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocationTrackerServer).StartSession(ctx, req.(*StartSessionRequest))
+		return srv.(LocationTrackerAdminServer).EnableUser(ctx, req.(*EnableUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocationTracker_StopSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopSessionRequest)
+func _LocationTrackerAdmin_SetUserRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserRoleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocationTrackerServer).StopSession(ctx, in)
+		return srv.(LocationTrackerAdminServer).SetUserRole(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.potpie.locationtracker.LocationTracker/StopSession",
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/SetUserRole",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocationTrackerServer).StopSession(ctx, req.(*StopSessionRequest))
+		return srv.(LocationTrackerAdminServer).SetUserRole(ctx, req.(*SetUserRoleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocationTracker_StartTracking_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(StartTrackingRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _LocationTrackerAdmin_GetUserSessionIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionIdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(LocationTrackerServer).StartTracking(m, &locationTrackerStartTrackingServer{stream})
-}
-
-type LocationTracker_StartTrackingServer interface {
-	Send(*TrackingData) error
-	grpc.ServerStream
-}
-
-type locationTrackerStartTrackingServer struct {
-	grpc.ServerStream
-}
-
-func (x *locationTrackerStartTrackingServer) Send(m *TrackingData) error {
-	return x.ServerStream.SendMsg(m)
+	if interceptor == nil {
+		return srv.(LocationTrackerAdminServer).GetUserSessionIds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/GetUserSessionIds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerAdminServer).GetUserSessionIds(ctx, req.(*SessionIdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _LocationTracker_StopTracking_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StopTrackingRequest)
+func _LocationTrackerAdmin_GetUserSessionData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SessionDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocationTrackerServer).StopTracking(ctx, in)
+		return srv.(LocationTrackerAdminServer).GetUserSessionData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.potpie.locationtracker.LocationTracker/StopTracking",
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/GetUserSessionData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocationTrackerServer).StopTracking(ctx, req.(*StopTrackingRequest))
+		return srv.(LocationTrackerAdminServer).GetUserSessionData(ctx, req.(*SessionDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocationTracker_ReportLocation_Handler(srv interface{}, stream grpc.ServerStream) error {
-	return srv.(LocationTrackerServer).ReportLocation(&locationTrackerReportLocationServer{stream})
-}
-
-type LocationTracker_ReportLocationServer interface {
-	SendAndClose(*ReportLocationResponse) error
-	Recv() (*TrackingData, error)
-	grpc.ServerStream
-}
-
-type locationTrackerReportLocationServer struct {
-	grpc.ServerStream
-}
-
-func (x *locationTrackerReportLocationServer) SendAndClose(m *ReportLocationResponse) error {
-	return x.ServerStream.SendMsg(m)
+func _LocationTrackerAdmin_CreateOrganization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOrganizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LocationTrackerAdminServer).CreateOrganization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/CreateOrganization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerAdminServer).CreateOrganization(ctx, req.(*CreateOrganizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (x *locationTrackerReportLocationServer) Recv() (*TrackingData, error) {
-	m := new(TrackingData)
-	if err := x.ServerStream.RecvMsg(m); err != nil {
+func _LocationTrackerAdmin_ListOrganizations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrganizationsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return m, nil
+	if interceptor == nil {
+		return srv.(LocationTrackerAdminServer).ListOrganizations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/ListOrganizations",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LocationTrackerAdminServer).ListOrganizations(ctx, req.(*ListOrganizationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _LocationTracker_GetSessionIds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionIdsRequest)
+func _LocationTrackerAdmin_GetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRetentionPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocationTrackerServer).GetSessionIds(ctx, in)
+		return srv.(LocationTrackerAdminServer).GetRetentionPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetSessionIds",
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/GetRetentionPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocationTrackerServer).GetSessionIds(ctx, req.(*SessionIdsRequest))
+		return srv.(LocationTrackerAdminServer).GetRetentionPolicy(ctx, req.(*GetRetentionPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _LocationTracker_GetSessionData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SessionDataRequest)
+func _LocationTrackerAdmin_SetRetentionPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetRetentionPolicyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(LocationTrackerServer).GetSessionData(ctx, in)
+		return srv.(LocationTrackerAdminServer).SetRetentionPolicy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/pb.potpie.locationtracker.LocationTracker/GetSessionData",
+		FullMethod: "/pb.potpie.locationtracker.LocationTrackerAdmin/SetRetentionPolicy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(LocationTrackerServer).GetSessionData(ctx, req.(*SessionDataRequest))
+		return srv.(LocationTrackerAdminServer).SetRetentionPolicy(ctx, req.(*SetRetentionPolicyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _LocationTracker_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "pb.potpie.locationtracker.LocationTracker",
-	HandlerType: (*LocationTrackerServer)(nil),
+var _LocationTrackerAdmin_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "pb.potpie.locationtracker.LocationTrackerAdmin",
+	HandlerType: (*LocationTrackerAdminServer)(nil),
 	Methods: []grpc.MethodDesc{
 		{
-			MethodName: "Register",
-			Handler:    _LocationTracker_Register_Handler,
+			MethodName: "ListUsers",
+			Handler:    _LocationTrackerAdmin_ListUsers_Handler,
 		},
 		{
-			MethodName: "GetTrackables",
-			Handler:    _LocationTracker_GetTrackables_Handler,
+			MethodName: "DisableUser",
+			Handler:    _LocationTrackerAdmin_DisableUser_Handler,
 		},
 		{
-			MethodName: "StartSession",
-			Handler:    _LocationTracker_StartSession_Handler,
+			MethodName: "EnableUser",
+			Handler:    _LocationTrackerAdmin_EnableUser_Handler,
 		},
 		{
-			MethodName: "StopSession",
-			Handler:    _LocationTracker_StopSession_Handler,
+			MethodName: "SetUserRole",
+			Handler:    _LocationTrackerAdmin_SetUserRole_Handler,
 		},
 		{
-			MethodName: "StopTracking",
-			Handler:    _LocationTracker_StopTracking_Handler,
+			MethodName: "GetUserSessionIds",
+			Handler:    _LocationTrackerAdmin_GetUserSessionIds_Handler,
 		},
 		{
-			MethodName: "GetSessionIds",
-			Handler:    _LocationTracker_GetSessionIds_Handler,
+			MethodName: "GetUserSessionData",
+			Handler:    _LocationTrackerAdmin_GetUserSessionData_Handler,
 		},
 		{
-			MethodName: "GetSessionData",
-			Handler:    _LocationTracker_GetSessionData_Handler,
+			MethodName: "CreateOrganization",
+			Handler:    _LocationTrackerAdmin_CreateOrganization_Handler,
 		},
-	},
-	Streams: []grpc.StreamDesc{
 		{
-			StreamName:    "StartTracking",
-			Handler:       _LocationTracker_StartTracking_Handler,
-			ServerStreams: true,
+			MethodName: "ListOrganizations",
+			Handler:    _LocationTrackerAdmin_ListOrganizations_Handler,
 		},
 		{
-			StreamName:    "ReportLocation",
-			Handler:       _LocationTracker_ReportLocation_Handler,
-			ClientStreams: true,
+			MethodName: "GetRetentionPolicy",
+			Handler:    _LocationTrackerAdmin_GetRetentionPolicy_Handler,
+		},
+		{
+			MethodName: "SetRetentionPolicy",
+			Handler:    _LocationTrackerAdmin_SetRetentionPolicy_Handler,
 		},
 	},
+	Streams:  []grpc.StreamDesc{},
 	Metadata: "locationtracker.proto",
 }
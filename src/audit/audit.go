@@ -0,0 +1,33 @@
+package audit
+
+import (
+	"potpie.org/locationtracker/src/db"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// Most audit entries returned for a request, also used when no limit is
+// given.
+const MaxLimit = 1000
+
+// Limit returns the number of entries to read for a requested limit.
+func Limit(limit int) int {
+	if limit <= 0 || limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
+
+// Record records an access by actor over transport to the data of target,
+// which was denied when err is set. Failing to record is logged rather than
+// failing the request.
+func Record(dbclient db.Client, actor string, action string, target string, transport string, err error) {
+	entry := db.AuditEntry{Actor: actor, Target: target, Action: action, Transport: transport, Outcome: db.AuditAllowed}
+	if err != nil {
+		entry.Outcome = db.AuditDenied
+		entry.Reason = err.Error()
+	}
+	if err := dbclient.RecordAudit(entry); err != nil {
+		logger.Warn(err)
+	}
+}
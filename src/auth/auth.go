@@ -0,0 +1,110 @@
+package auth
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"potpie.org/locationtracker/src/db"
+)
+
+type Identity struct {
+	Org       string
+	UserId    int
+	UserName  string
+	TokenId   string
+	IssuedAt  int64
+	ExpiresAt int64
+	Role      string
+	// ApiKeyId is set when the caller authenticated with a device API key,
+	// which only permits reporting locations for UserName.
+	ApiKeyId string
+}
+
+type Tokens struct {
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    int64
+}
+
+type Authenticator interface {
+	Login(org string, username string, password string) (Tokens, error)
+	Refresh(refreshtoken string) (Tokens, error)
+	Revoke(identity *Identity, refreshtoken string) error
+	Authenticate(accesstoken string) (Identity, error)
+	CreateApiKey(org string, username string, label string) (db.ApiKey, string, error)
+	AuthenticateApiKey(apikey string) (Identity, error)
+	CreateShareLink(org string, username string, duration time.Duration, sessionid string) (db.ShareLink, string, error)
+	AuthenticateShareLink(token string) (db.ShareLink, error)
+	UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error)
+	StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error
+}
+
+type identityKey struct{}
+
+func NewContext(ctx context.Context, identity Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, identity)
+}
+
+func FromContext(ctx context.Context) (Identity, bool) {
+	identity, ok := ctx.Value(identityKey{}).(Identity)
+	return identity, ok
+}
+
+// CheckUser verifies that the caller authenticated on ctx is acting as username.
+func CheckUser(ctx context.Context, username string) error {
+	identity, ok := FromContext(ctx)
+	if !ok {
+		return db.NewError(db.ErrUnauthenticated, "Not authenticated")
+	}
+	return identity.CheckUser(username)
+}
+
+func (identity Identity) CheckUser(username string) error {
+	if identity.UserName != username {
+		return db.NewError(db.ErrPermissionDenied, "User %s is not authorized to act as %s", identity.UserName, username)
+	}
+	return nil
+}
+
+// CheckWatcher verifies that identity is trackeename or one of their approved watchers.
+func (identity Identity) CheckWatcher(dbclient db.Client, trackeename string) error {
+	if identity.UserName == trackeename {
+		return nil
+	}
+	approved, err := dbclient.IsWatcher(trackeename, identity.UserName)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return db.NewError(db.ErrPermissionDenied, "User %s is not approved to track %s", identity.UserName, trackeename)
+	}
+	return nil
+}
+
+// CheckActive verifies that the access token of identity has neither expired
+// nor been revoked and that its user has not been disabled since it
+// authenticated.
+func (identity Identity) CheckActive(dbclient db.Client) error {
+	if identity.ExpiresAt != 0 && time.Now().Unix() >= identity.ExpiresAt {
+		return db.NewError(db.ErrUnauthenticated, "Access token has expired")
+	}
+	if identity.TokenId != "" {
+		revoked, err := dbclient.IsAccessTokenRevoked(identity.TokenId, identity.UserId, identity.IssuedAt)
+		if err != nil {
+			return err
+		}
+		if revoked {
+			return db.NewError(db.ErrUnauthenticated, "Access token has been revoked")
+		}
+	}
+	_, disabled, err := dbclient.GetUserAccess(identity.UserId)
+	if err != nil {
+		return err
+	}
+	if disabled {
+		return db.NewError(db.ErrPermissionDenied, "User %s is disabled", identity.UserName)
+	}
+	return nil
+}
@@ -0,0 +1,293 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/settings"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+type authenticator struct {
+	dbclient        db.Client
+	secret          []byte
+	accessTokenTTL  time.Duration
+	refreshTokenTTL time.Duration
+	shareLinkMaxTTL time.Duration
+}
+
+type claims struct {
+	Org       string `json:"org,omitempty"`
+	UserId    int    `json:"uid"`
+	UserName  string `json:"sub"`
+	TokenId   string `json:"jti"`
+	IssuedAt  int64  `json:"iat"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+func NewAuthenticator(dbclient db.Client) Authenticator {
+	s := settings.NewSettings()
+
+	secret := []byte(s.TokenSecret)
+	if len(secret) == 0 {
+		logger.Warn("TOKEN_SECRET is not set, issued tokens will not survive a restart")
+		secret = make([]byte, 32)
+		if _, err := rand.Read(secret); err != nil {
+			panic(err)
+		}
+	}
+	return &authenticator{
+		dbclient:        dbclient,
+		secret:          secret,
+		accessTokenTTL:  s.AccessTokenTTL,
+		refreshTokenTTL: s.RefreshTokenTTL,
+		shareLinkMaxTTL: s.ShareLinkMaxTTL,
+	}
+}
+
+func (a *authenticator) Login(org string, username string, password string) (Tokens, error) {
+	exists, err := a.dbclient.OrganizationExists(org)
+	if err != nil {
+		return Tokens{}, err
+	}
+	if !exists {
+		return Tokens{}, db.NewError(db.ErrUnauthenticated, "Invalid username or password")
+	}
+	dbclient := a.dbclient.ForOrg(org)
+	userid, err := dbclient.Login(username, password)
+	if err != nil {
+		logger.Warnf("Login failed for %s", username)
+		return Tokens{}, err
+	}
+	return a.issueTokens(dbclient, userid, username)
+}
+
+func (a *authenticator) Refresh(refreshtoken string) (Tokens, error) {
+	org, refreshtoken := splitOrg(refreshtoken)
+	dbclient := a.dbclient.ForOrg(org)
+	userid, err := dbclient.TakeRefreshToken(refreshtoken)
+	if err != nil {
+		return Tokens{}, err
+	}
+	username, err := dbclient.GetUserName(userid)
+	if err != nil {
+		return Tokens{}, err
+	}
+	_, disabled, err := dbclient.GetUserAccess(userid)
+	if err != nil {
+		return Tokens{}, err
+	}
+	if disabled {
+		return Tokens{}, db.NewError(db.ErrPermissionDenied, "User %s is disabled", username)
+	}
+	return a.issueTokens(dbclient, userid, username)
+}
+
+func (a *authenticator) Revoke(identity *Identity, refreshtoken string) error {
+	if refreshtoken != "" {
+		org, refreshtoken := splitOrg(refreshtoken)
+		userid, err := a.dbclient.ForOrg(org).TakeRefreshToken(refreshtoken)
+		if err != nil {
+			return err
+		}
+		if identity != nil && (identity.Org != org || identity.UserId != userid) {
+			return db.NewError(db.ErrPermissionDenied, "Refresh token does not belong to %s", identity.UserName)
+		}
+	}
+	if identity != nil {
+		ttl := time.Until(time.Unix(identity.ExpiresAt, 0))
+		return a.dbclient.ForOrg(identity.Org).RevokeAccessToken(identity.TokenId, ttl)
+	}
+	return nil
+}
+
+func (a *authenticator) Authenticate(accesstoken string) (Identity, error) {
+	parts := strings.Split(accesstoken, ".")
+	if len(parts) != 2 {
+		return Identity{}, db.NewError(db.ErrUnauthenticated, "Malformed access token")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[1])
+	if err != nil || !hmac.Equal(signature, a.sign(parts[0])) {
+		return Identity{}, db.NewError(db.ErrUnauthenticated, "Invalid access token")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(parts[0])
+	if err != nil {
+		return Identity{}, db.NewError(db.ErrUnauthenticated, "Malformed access token")
+	}
+	var c claims
+	err = json.Unmarshal(payload, &c)
+	if err != nil {
+		return Identity{}, db.NewError(db.ErrUnauthenticated, "Malformed access token")
+	}
+	if time.Now().Unix() >= c.ExpiresAt {
+		return Identity{}, db.NewError(db.ErrUnauthenticated, "Access token has expired")
+	}
+	dbclient := a.dbclient.ForOrg(c.Org)
+	revoked, err := dbclient.IsAccessTokenRevoked(c.TokenId, c.UserId, c.IssuedAt)
+	if err != nil {
+		return Identity{}, err
+	}
+	if revoked {
+		return Identity{}, db.NewError(db.ErrUnauthenticated, "Access token has been revoked")
+	}
+	role, disabled, err := dbclient.GetUserAccess(c.UserId)
+	if err != nil {
+		return Identity{}, err
+	}
+	if disabled {
+		return Identity{}, db.NewError(db.ErrPermissionDenied, "User %s is disabled", c.UserName)
+	}
+
+	return Identity{Org: c.Org, UserId: c.UserId, UserName: c.UserName, TokenId: c.TokenId, IssuedAt: c.IssuedAt, ExpiresAt: c.ExpiresAt, Role: role}, nil
+}
+
+func (a *authenticator) CreateApiKey(org string, username string, label string) (db.ApiKey, string, error) {
+	keyid, err := randomToken(8)
+	if err != nil {
+		return db.ApiKey{}, "", err
+	}
+	secret, err := randomToken(32)
+	if err != nil {
+		return db.ApiKey{}, "", err
+	}
+	apikey, err := a.dbclient.ForOrg(org).StoreApiKey(username, keyid, secret, label)
+	if err != nil {
+		return db.ApiKey{}, "", err
+	}
+	return apikey, joinOrg(org, keyid+"."+secret), nil
+}
+
+func (a *authenticator) AuthenticateApiKey(apikey string) (Identity, error) {
+	org, apikey := splitOrg(apikey)
+	parts := strings.Split(apikey, ".")
+	if len(parts) != 2 {
+		return Identity{}, db.NewError(db.ErrUnauthenticated, "Malformed API key")
+	}
+	dbclient := a.dbclient.ForOrg(org)
+	userid, err := dbclient.CheckApiKey(parts[0], parts[1])
+	if err != nil {
+		return Identity{}, err
+	}
+	username, err := dbclient.GetUserName(userid)
+	if err != nil {
+		return Identity{}, err
+	}
+	role, disabled, err := dbclient.GetUserAccess(userid)
+	if err != nil {
+		return Identity{}, err
+	}
+	if disabled {
+		return Identity{}, db.NewError(db.ErrPermissionDenied, "User %s is disabled", username)
+	}
+	return Identity{Org: org, UserId: userid, UserName: username, Role: role, ApiKeyId: parts[0]}, nil
+}
+
+func (a *authenticator) CreateShareLink(org string, username string, duration time.Duration, sessionid string) (db.ShareLink, string, error) {
+	if duration <= 0 || duration > a.shareLinkMaxTTL {
+		return db.ShareLink{}, "", db.InvalidField("durationSeconds", "Share link duration must be between 1s and %s", a.shareLinkMaxTTL)
+	}
+	dbclient := a.dbclient.ForOrg(org)
+	if sessionid != "" {
+		owner, err := dbclient.GetSessionOwner(sessionid)
+		if err != nil {
+			return db.ShareLink{}, "", err
+		}
+		if owner != username {
+			return db.ShareLink{}, "", db.NewError(db.ErrPermissionDenied, "Session %s does not belong to %s", sessionid, username)
+		}
+	}
+	shareid, err := randomToken(8)
+	if err != nil {
+		return db.ShareLink{}, "", err
+	}
+	secret, err := randomToken(32)
+	if err != nil {
+		return db.ShareLink{}, "", err
+	}
+	sharelink, err := dbclient.StoreShareLink(username, shareid, secret, sessionid, time.Now().Add(duration).Unix())
+	if err != nil {
+		return db.ShareLink{}, "", err
+	}
+	return sharelink, joinOrg(org, shareid+"."+secret), nil
+}
+
+func (a *authenticator) AuthenticateShareLink(token string) (db.ShareLink, error) {
+	org, token := splitOrg(token)
+	parts := strings.Split(token, ".")
+	if len(parts) != 2 {
+		return db.ShareLink{}, db.NewError(db.ErrUnauthenticated, "Malformed share link")
+	}
+	return a.dbclient.ForOrg(org).CheckShareLink(parts[0], parts[1])
+}
+
+func (a *authenticator) issueTokens(dbclient db.Client, userid int, username string) (Tokens, error) {
+	tokenid, err := randomToken(16)
+	if err != nil {
+		return Tokens{}, err
+	}
+	now := time.Now()
+	c := claims{
+		Org:       dbclient.Org(),
+		UserId:    userid,
+		UserName:  username,
+		TokenId:   tokenid,
+		IssuedAt:  now.Unix(),
+		ExpiresAt: now.Add(a.accessTokenTTL).Unix(),
+	}
+	payload, err := json.Marshal(c)
+	if err != nil {
+		return Tokens{}, err
+	}
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	accesstoken := encoded + "." + base64.RawURLEncoding.EncodeToString(a.sign(encoded))
+
+	refreshtoken, err := randomToken(32)
+	if err != nil {
+		return Tokens{}, err
+	}
+	err = dbclient.StoreRefreshToken(refreshtoken, userid, a.refreshTokenTTL)
+	if err != nil {
+		return Tokens{}, err
+	}
+	logger.Infof("Issued tokens for %s", username)
+
+	return Tokens{AccessToken: accesstoken, RefreshToken: joinOrg(c.Org, refreshtoken), ExpiresAt: c.ExpiresAt}, nil
+}
+
+func (a *authenticator) sign(payload string) []byte {
+	mac := hmac.New(sha256.New, a.secret)
+	mac.Write([]byte(payload))
+	return mac.Sum(nil)
+}
+
+func randomToken(size int) (string, error) {
+	b := make([]byte, size)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// Credentials for organizations other than the default one are prefixed with
+// the organization so they can be resolved without a global index.
+func joinOrg(org string, token string) string {
+	if org == "" {
+		return token
+	}
+	return org + ":" + token
+}
+
+func splitOrg(token string) (string, string) {
+	if i := strings.Index(token, ":"); i >= 0 {
+		return token[:i], token[i+1:]
+	}
+	return "", token
+}
@@ -0,0 +1,83 @@
+package auth
+
+import (
+	"context"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+type authenticatedStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *authenticatedStream) Context() context.Context {
+	return s.ctx
+}
+
+func (a *authenticator) UnaryInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, err := a.authenticateContext(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (a *authenticator) StreamInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := a.authenticateContext(stream.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &authenticatedStream{stream, ctx})
+}
+
+func (a *authenticator) authenticateContext(ctx context.Context, fullMethod string) (context.Context, error) {
+	method := MethodName(fullMethod)
+
+	var identity *Identity
+	if apikey := metadataValue(ctx, "x-api-key"); apikey != "" {
+		id, err := a.AuthenticateApiKey(apikey)
+		if err != nil {
+			return nil, err
+		}
+		identity = &id
+	} else if token := bearerToken(ctx); token != "" {
+		id, err := a.Authenticate(token)
+		if err != nil && !publicMethods[method] {
+			return nil, err
+		}
+		if err == nil {
+			identity = &id
+		}
+	}
+
+	if err := Authorize(identity, method); err != nil {
+		return nil, err
+	}
+	if identity == nil {
+		return ctx, nil
+	}
+	return NewContext(ctx, *identity), nil
+}
+
+func bearerToken(ctx context.Context) string {
+	value := metadataValue(ctx, "authorization")
+	if strings.HasPrefix(value, "Bearer ") {
+		return strings.TrimPrefix(value, "Bearer ")
+	}
+	return ""
+}
+
+func metadataValue(ctx context.Context, key string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
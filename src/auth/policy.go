@@ -0,0 +1,96 @@
+package auth
+
+import (
+	"strings"
+
+	"potpie.org/locationtracker/src/db"
+)
+
+// The policy is expressed in terms of method names so the same rules apply
+// to gRPC calls and to their WebSocket equivalents.
+
+// Methods that may be called without authenticating.
+var publicMethods = map[string]bool{
+	"Register":     true,
+	"Login":        true,
+	"RefreshToken": true,
+	"RevokeToken":  true,
+	"Authenticate": true,
+}
+
+// Methods that may be called with a device API key.
+var apiKeyMethods = map[string]bool{
+	"ReportLocation": true,
+}
+
+// Methods restricted to particular roles, anything else is open to every
+// authenticated user.
+var rolePolicy = map[string][]string{
+	"ListUsers":          {db.RoleAdmin, db.RoleSupport},
+	"GetUserSessionIds":  {db.RoleAdmin, db.RoleSupport},
+	"GetUserSessionData": {db.RoleAdmin, db.RoleSupport},
+	"DisableUser":        {db.RoleAdmin},
+	"EnableUser":         {db.RoleAdmin},
+	"SetUserRole":        {db.RoleAdmin},
+	"GetRetentionPolicy": {db.RoleAdmin},
+	"SetRetentionPolicy": {db.RoleAdmin},
+}
+
+// Methods restricted to administrators of the default organization, who
+// operate the deployment as a whole.
+var platformMethods = map[string]bool{
+	"CreateOrganization": true,
+	"ListOrganizations":  true,
+}
+
+// Authorize applies the access policy to a call of method by identity, which
+// is nil for unauthenticated callers.
+func Authorize(identity *Identity, method string) error {
+	if publicMethods[method] {
+		return nil
+	}
+	if identity == nil {
+		return db.NewError(db.ErrUnauthenticated, "Not authenticated")
+	}
+	if identity.ApiKeyId != "" {
+		if !apiKeyMethods[method] {
+			return db.NewError(db.ErrPermissionDenied, "API keys may not be used for %s", method)
+		}
+		return nil
+	}
+	if platformMethods[method] {
+		if identity.Org != "" || identity.Role != db.RoleAdmin {
+			return db.NewError(db.ErrPermissionDenied, "User %s is not permitted to call %s", identity.UserName, method)
+		}
+		return nil
+	}
+	roles, ok := rolePolicy[method]
+	if !ok {
+		return nil
+	}
+	for _, role := range roles {
+		if identity.Role == role {
+			return nil
+		}
+	}
+	return db.NewError(db.ErrPermissionDenied, "User %s is not permitted to call %s", identity.UserName, method)
+}
+
+// MethodName strips the service from a full gRPC method name.
+func MethodName(fullMethod string) string {
+	return fullMethod[strings.LastIndex(fullMethod, "/")+1:]
+}
+
+// CheckRegistration verifies that identity, which is nil for unauthenticated
+// callers, may register a user in org. Anyone may sign up to the default
+// organization, users of any other are registered by its administrators or
+// by those of the platform.
+func CheckRegistration(identity *Identity, org string) error {
+	if org == "" {
+		return nil
+	}
+	if identity == nil || identity.ApiKeyId != "" || identity.Role != db.RoleAdmin || (identity.Org != "" && identity.Org != org) {
+		return db.NewError(db.ErrPermissionDenied, "Only administrators may register users in organization %s", org)
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package auth
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"potpie.org/locationtracker/src/db"
+)
+
+func TestCheckRegistration(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity *Identity
+		org      string
+		allowed  bool
+	}{
+		{"default org", nil, "", true},
+		{"unauthenticated", nil, "acme", false},
+		{"user of the org", &Identity{Org: "acme", Role: db.RoleUser}, "acme", false},
+		{"admin of the org", &Identity{Org: "acme", Role: db.RoleAdmin}, "acme", true},
+		{"admin of another org", &Identity{Org: "other", Role: db.RoleAdmin}, "acme", false},
+		{"platform admin", &Identity{Role: db.RoleAdmin}, "acme", true},
+		{"api key", &Identity{Org: "acme", Role: db.RoleAdmin, ApiKeyId: "key"}, "acme", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if err := CheckRegistration(test.identity, test.org); (err == nil) != test.allowed {
+				t.Errorf("Got %v, want allowed %v", err, test.allowed)
+			}
+		})
+	}
+}
+
+func TestCheckActiveExpired(t *testing.T) {
+	// expiry is checked before the database, which is not needed here
+	identity := Identity{UserName: "alice", ExpiresAt: time.Now().Add(-time.Minute).Unix()}
+	err := identity.CheckActive(nil)
+	var dberr *db.Error
+	if !errors.As(err, &dberr) || dberr.Kind != db.ErrUnauthenticated {
+		t.Errorf("Expired identity got %v", err)
+	}
+}
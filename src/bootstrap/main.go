@@ -1,16 +1,60 @@
 package main
 
 import (
+	"os"
+
+	"potpie.org/locationtracker/src/auth"
+	"potpie.org/locationtracker/src/db"
 	"potpie.org/locationtracker/src/server"
 	ltservice "potpie.org/locationtracker/src/service"
 	wsservice "potpie.org/locationtracker/src/ws"
 
 	"potpie.org/locationtracker/src/settings"
+
+	logger "github.com/sirupsen/logrus"
 )
 
 func main() {
-	handler := wsservice.StartService()
-	srv := server.NewServer(settings.GrpcUnaryInterceptor(nil))
-	ltservice.StartService(srv.GrpcServer())
+	// "rotate-keys" re-encrypts stored locations and exits
+	if len(os.Args) > 1 && os.Args[1] == "rotate-keys" {
+		if err := ltservice.RotateKeys(); err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+	// "grant-admin" makes the registered users named after it administrators
+	// of the platform and exits
+	if len(os.Args) > 1 && os.Args[1] == "grant-admin" {
+		if err := ltservice.GrantAdmin(os.Args[2:]); err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+	// "migrate" updates data stored by earlier versions and exits
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := ltservice.Migrate(); err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+	// "ws-schema" prints the JSON Schema of the WebSocket protocol and exits
+	if len(os.Args) > 1 && os.Args[1] == "ws-schema" {
+		schema, err := wsservice.Schema()
+		if err != nil {
+			logger.Fatal(err)
+		}
+		os.Stdout.Write(append(schema, '\n'))
+		return
+	}
+
+	authenticator := auth.NewAuthenticator(db.NewClient())
+	handler := wsservice.StartService(authenticator)
+	srv := server.NewServer(
+		settings.GrpcUnaryInterceptor(ltservice.UnaryStatusInterceptor, authenticator.UnaryInterceptor),
+		settings.GrpcStreamInterceptor(ltservice.StreamStatusInterceptor, authenticator.StreamInterceptor))
+	ltservice.StartService(srv.GrpcServer(), authenticator)
+	ltservice.StartAdminService(srv.GrpcServer())
+	ltservice.StartJanitor()
+	ltservice.StartCompactor()
 	srv.Start(handler)
 }
@@ -1,29 +1,314 @@
 package db
 
+import "time"
+
+// TrackingData is a reported location. Locationid is only used within the
+// server and is never sent to clients.
 type TrackingData struct {
-	Locationid int64
+	Locationid int64 `json:"-"`
 	Longitude  float64
 	Latitude   float64
 	Timestamp  int64
 }
 
+const (
+	RoleUser    = "user"
+	RoleAdmin   = "admin"
+	RoleSupport = "support"
+)
+
+type Organization struct {
+	Name      string
+	CreatedAt int64
+}
+
+type User struct {
+	Id        int
+	UserName  string
+	Role      string
+	Trackable bool
+	Disabled  bool
+}
+
+// SessionId identifies a session by an opaque key, the sequential ids used
+// for storage are never exposed.
 type SessionId struct {
-	Id        int64
+	Id        string
+	Timestamp int64
+}
+
+type ApiKey struct {
+	Id         string
+	Label      string
+	CreatedAt  int64
+	LastUsedAt int64
+}
+
+const (
+	PrecisionExact = "exact"
+	Precision100m  = "100m"
+	Precision1km   = "1km"
+	Precision10km  = "10km"
+)
+
+// Watcher is a user who has asked, or been approved, to track another.
+// Precision is the resolution approved watchers see locations at.
+type Watcher struct {
+	UserName  string
+	Timestamp int64
+	Precision string
+}
+
+type ShareLink struct {
+	Id        string
+	Org       string
+	UserName  string
+	SessionId string
+	CreatedAt int64
+	ExpiresAt int64
+}
+
+// Group is a named set of trackees owned by a watcher.
+type Group struct {
+	Name      string
+	Members   []string
+	CreatedAt int64
+}
+
+// SessionExport is a session along with the locations recorded in it.
+type SessionExport struct {
+	Id        string
 	Timestamp int64
+	Summary   SessionSummary
+	Data      []TrackingData
+}
+
+// UserExport is everything stored about a user.
+type UserExport struct {
+	Org             string
+	UserName        string
+	Role            string
+	Trackable       bool
+	ApiKeys         []ApiKey
+	Sessions        []SessionExport
+	PendingWatchers []Watcher
+	Watchers        []Watcher
+	Watching        []Watcher
+	Groups          []Group
+	ShareLinks      []ShareLink
+	PrivacyZones    []PrivacyZone
+	AuditLog        []AuditEntry
+}
+
+// RetainForever keeps data regardless of its age. A zero duration in a
+// RetentionPolicy inherits the organization or deployment setting instead.
+const RetainForever time.Duration = -1
+
+// RetentionPolicy sets how long the raw location points of a session are
+// kept, and how long the session and its summary are kept.
+type RetentionPolicy struct {
+	Locations time.Duration
+	Sessions  time.Duration
+}
+
+// SessionSummary describes a session, it outlives the raw points.
+type SessionSummary struct {
+	Id           string
+	StartedAt    int64
+	EndedAt      int64
+	Points       int
+	Distance     float64
+	MinLatitude  float64
+	MinLongitude float64
+	MaxLatitude  float64
+	MaxLongitude float64
+	Purged       bool
+	Compacted    bool
 }
 
-type MonitorFunc func(locationkey string) error
+// PurgeStats counts what a purge removed.
+type PurgeStats struct {
+	Sessions  int
+	Locations int
+}
+
+const (
+	CompactByTime     = "time"
+	CompactByDistance = "distance"
+)
+
+// CompactionOptions sets the resolution old sessions are reduced to. Kept
+// points are at least Spacing apart, in meters when compacting by distance
+// or in the units of the reported timestamps when compacting by time.
+// Points where the heading turns by more than TurnAngle degrees are kept
+// regardless.
+type CompactionOptions struct {
+	Mode      string
+	Spacing   float64
+	TurnAngle float64
+}
+
+// CompactionStats counts what a compaction rewrote.
+type CompactionStats struct {
+	Sessions  int
+	Locations int
+}
+
+// RotationStats counts what a key rotation rewrote.
+type RotationStats struct {
+	Users     int
+	Locations int
+}
+
+const (
+	ZoneHide = "hide"
+	ZoneSnap = "snap"
+)
+
+// PrivacyZone is a circle, radius in meters, inside which the locations of
+// its owner are hidden from others or snapped to its edge.
+type PrivacyZone struct {
+	Id        string
+	Name      string
+	Latitude  float64
+	Longitude float64
+	Radius    float64
+	Mode      string
+}
+
+// Transports access to data is audited for.
+const (
+	TransportGrpc      = "grpc"
+	TransportWebSocket = "websocket"
+	TransportShare     = "share"
+)
+
+// Outcomes of an audited access.
+const (
+	AuditAllowed = "allowed"
+	AuditDenied  = "denied"
+)
+
+// AuditEntry records an attempt by Actor to access the data of Target,
+// which is "" for administrative actions not aimed at a user. Reason
+// explains a denial.
+type AuditEntry struct {
+	Timestamp int64
+	Actor     string
+	Target    string
+	Action    string
+	Transport string
+	Outcome   string
+	Reason    string
+}
+
+// Pause is set while a trackee has paused sharing. Until is 0 for an
+// indefinite pause, locations after LastLocationId were reported while
+// paused.
+type Pause struct {
+	Since          int64
+	Until          int64
+	LastLocationId int64
+}
+
+// Active reports whether the pause is in effect at now.
+func (p Pause) Active(now time.Time) bool {
+	return p.Since != 0 && (p.Until == 0 || now.Unix() < p.Until)
+}
+
+// Statuses published to watchers when a trackee pauses or resumes sharing.
+const (
+	StatusPaused  = "paused"
+	StatusResumed = "resumed"
+)
+
+// Update is delivered to watchers for each location reported, or with a
+// Status when the trackee pauses or resumes sharing.
+type Update struct {
+	LocationKey string
+	Status      string
+	Until       int64
+}
+
+type MonitorFunc func(update Update) error
+
+// GroupMonitorFunc is called with the group member an update belongs to.
+type GroupMonitorFunc func(trackeename string, update Update) error
 
+// Client stores everything for a single organization, use ForOrg to get a
+// client for another one.
 type Client interface {
-	Register(username string, trackable bool) (int, error)
+	ForOrg(org string) Client
+	Org() string
+	CreateOrganization(name string, adminusername string, adminpassword string) error
+	GetOrganizations() ([]Organization, error)
+	OrganizationExists(name string) (bool, error)
+	Register(username string, password string, trackable bool) (int, error)
+	Login(username string, password string) (int, error)
+	ChangePassword(username string, oldpassword string, newpassword string) error
+	GetUserName(userid int) (string, error)
+	GetUserAccess(userid int) (string, bool, error)
+	ListUsers(cursor string, count int) ([]User, string, error)
+	SetUserRole(username string, role string) error
+	SetUserDisabled(username string, disabled bool) error
+	DeleteUser(username string, password string) error
+	ExportUser(username string) (UserExport, error)
+	StoreRefreshToken(token string, userid int, ttl time.Duration) error
+	TakeRefreshToken(token string) (int, error)
+	RevokeAccessToken(tokenid string, ttl time.Duration) error
+	IsAccessTokenRevoked(tokenid string, userid int, issuedat int64) (bool, error)
+	StoreApiKey(username string, keyid string, secret string, label string) (ApiKey, error)
+	GetApiKeys(username string) ([]ApiKey, error)
+	LabelApiKey(username string, keyid string, label string) error
+	RevokeApiKey(username string, keyid string) error
+	CheckApiKey(keyid string, secret string) (int, error)
 	GetTrackables() ([]string, error)
-	StartSession(username string) (int, error)
+	StartSession(username string) (string, error)
 	StopSession(username string) error
 	StartTracking(trackeename string, username string) error
 	StopTracking(trackeename string, username string) error
+	RequestTracking(trackeename string, username string) error
+	ApproveTracking(trackeename string, username string) error
+	DenyTracking(trackeename string, username string) error
+	RevokeTracking(trackeename string, username string) error
+	GetWatchers(username string) ([]Watcher, []Watcher, error)
+	IsWatcher(trackeename string, username string) (bool, error)
+	GetTrackingPrecision(trackeename string, username string) (string, error)
+	SetTrackingPrecision(trackeename string, username string, precision string) error
+	// ReportLocation fails with ErrFailedPrecondition, storing nothing, unless
+	// the user has started a session.
 	ReportLocation(username string, longitude float64, latitude float64, timestamp int64) error
+	PauseSharing(username string, until int64) error
+	ResumeSharing(username string) error
+	GetPause(username string) (Pause, error)
 	GetSessionIds(username string) ([]SessionId, error)
-	GetSessionData(sessionid int64) ([]TrackingData, error)
-	MonitorLocation(trackeename string, username string, cb MonitorFunc) error
+	GetSessionData(sessionid string) ([]TrackingData, error)
+	GetSessionOwner(sessionid string) (string, error)
+	GetCurrentSession(username string) (string, error)
+	GetSessionSummary(sessionid string) (SessionSummary, error)
+	GetRetentionPolicy(username string) (RetentionPolicy, error)
+	SetRetentionPolicy(username string, policy RetentionPolicy) error
+	PurgeExpired(defaults RetentionPolicy, cursor string, count int) (PurgeStats, string, error)
+	CompactSessions(olderthan time.Duration, options CompactionOptions, cursor string, count int) (CompactionStats, string, error)
+	RotateKeys(cursor string, count int) (RotationStats, string, error)
+	MigrateSessions(cursor string, count int) (int, string, error)
+	StoreShareLink(username string, shareid string, secret string, sessionid string, expiresat int64) (ShareLink, error)
+	GetShareLinks(username string) ([]ShareLink, error)
+	RevokeShareLink(username string, shareid string) error
+	CheckShareLink(shareid string, secret string) (ShareLink, error)
+	CreateGroup(username string, groupname string) (Group, error)
+	DeleteGroup(username string, groupname string) error
+	GetGroups(username string) ([]Group, error)
+	AddGroupMember(username string, groupname string, trackeename string) error
+	RemoveGroupMember(username string, groupname string, trackeename string) error
+	CreatePrivacyZone(username string, zone PrivacyZone) (PrivacyZone, error)
+	GetPrivacyZones(username string) ([]PrivacyZone, error)
+	DeletePrivacyZone(username string, zoneid string) error
+	RecordAudit(entry AuditEntry) error
+	GetAuditLog(username string, since int64, count int) ([]AuditEntry, error)
+	// MonitorLocation and MonitorGroup deliver updates until the callback
+	// fails, or return nil once done is closed.
+	MonitorLocation(trackeename string, username string, done <-chan struct{}, cb MonitorFunc) error
+	MonitorGroup(username string, groupname string, done <-chan struct{}, cb GroupMonitorFunc) error
 	GetLocation(locationkey string) (TrackingData, error)
 }
@@ -1,19 +1,53 @@
 package db
 
 import (
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"potpie.org/locationtracker/src/settings"
 
 	"github.com/gomodule/redigo/redis"
 	logger "github.com/sirupsen/logrus"
+	"golang.org/x/crypto/bcrypt"
 )
 
 type client struct {
 	pool *redis.Pool
+	// org is the organization the client is scoped to, "" for the default
+	// organization whose keys are left unprefixed.
+	org string
+	// keyring wraps the data keys coordinates are encrypted with, nil when
+	// they are stored in plaintext.
+	keyring *keyring
 }
 
+var orgNamePattern = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{0,62}$`)
+
+// Number of location keys deleted per command when purging.
+const purgeChunkSize = 500
+
+// Largest privacy zone radius in meters.
+const maxZoneRadius = 50000
+
+// Number of audit entries read per command when querying.
+const auditPageSize = 100
+
+// Legs shorter than this many meters are not considered for turn points.
+const turnMinDistance = 5
+
+var groupNamePattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9 _.-]{0,62}$`)
+
 func NewClient() Client {
 	s := settings.NewSettings()
 
@@ -22,334 +56,3086 @@ func NewClient() Client {
 		IdleTimeout: 240 * time.Second,
 		Dial:        func() (redis.Conn, error) { return redis.Dial("tcp", s.RedisUrl) },
 	}
+	masterkeys, err := loadKeyring(s)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	return &client{
+		pool:    pool,
+		keyring: masterkeys,
+	}
+}
+
+func (c *client) ForOrg(org string) Client {
+	if org == c.org {
+		return c
+	}
 	return &client{
-		pool: pool,
+		pool:    c.pool,
+		org:     org,
+		keyring: c.keyring,
 	}
 }
 
-func (c *client) Register(username string, trackable bool) (int, error) {
+func (c *client) Org() string {
+	return c.org
+}
+
+// CreateOrganization creates an organization along with its first
+// administrator. The organization is removed again when they cannot be
+// registered, so that none is left without one.
+func (c *client) CreateOrganization(name string, adminusername string, adminpassword string) error {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	existing, err := redis.Bool(conn.Do("HEXISTS", "users", username))
+	if !orgNamePattern.MatchString(name) {
+		return InvalidField("name", "Invalid organization name %s", name)
+	}
+	if adminpassword == "" {
+		return InvalidField("adminPassword", "A password is required to register %s", adminusername)
+	}
+	created, err := redis.Bool(conn.Do("HSETNX", "organizations", name, time.Now().Unix()))
 	if err != nil {
-		logger.Fatal(err)
+		logger.Warn(err)
+		return err
+	}
+	if !created {
+		return NewError(ErrAlreadyExists, "Organization %s already exists", name)
+	}
+	orgclient := &client{pool: c.pool, org: name, keyring: c.keyring}
+	if _, err := orgclient.register(conn, adminusername, adminpassword, false, RoleAdmin); err != nil {
+		if _, err := conn.Do("HDEL", "organizations", name); err != nil {
+			logger.Warn(err)
+		}
+		return err
+	}
+	logger.Infof("Created organization %s administered by %s", name, adminusername)
+
+	return nil
+}
+
+func (c *client) GetOrganizations() ([]Organization, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	values, err := redis.Int64Map(conn.Do("HGETALL", "organizations"))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+	results := []Organization{}
+	for name, createdat := range values {
+		results = append(results, Organization{name, createdat})
+	}
+	return results, nil
+}
+
+func (c *client) OrganizationExists(name string) (bool, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if name == "" {
+		return true, nil
+	}
+	exists, err := redis.Bool(conn.Do("HEXISTS", "organizations", name))
+	if err != nil {
+		logger.Warn(err)
+		return false, err
+	}
+	return exists, nil
+}
+
+func (c *client) Register(username string, password string, trackable bool) (int, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	return c.register(conn, username, password, trackable, RoleUser)
+}
+
+func (c *client) register(conn redis.Conn, username string, password string, trackable bool, role string) (int, error) {
+	if password == "" {
+		return -1, InvalidField("password", "A password is required to register %s", username)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return -1, err
+	}
+
+	existing, err := redis.Bool(conn.Do("HEXISTS", c.key("users"), username))
+	if err != nil {
+		logger.Warn(err)
 		return -1, err
 	}
 	if existing == true {
 		logger.Warnf("User %s is already registered", username)
-		return -1, fmt.Errorf("User %s is already registered", username)
+		return -1, NewError(ErrAlreadyExists, "User %s is already registered", username)
 	}
-	userid, err := redis.Int(conn.Do("INCR", "next_user_id"))
+	userid, err := redis.Int(conn.Do("INCR", c.key("next_user_id")))
 	if err != nil {
-		logger.Fatal(err)
+		logger.Warn(err)
 		return -1, err
 	}
-	userkey := fmt.Sprintf("user:%d", userid)
-	_, err = conn.Do("HSET", "users", username, userid)
+	userkey := c.key("user:%d", userid)
+	_, err = conn.Do("HSET", c.key("users"), username, userid)
 	if err != nil {
-		logger.Fatal(err)
+		logger.Warn(err)
 		return -1, err
 	}
 
-	_, err = conn.Do("HSET", userkey, "username", username, "trackable", trackable)
+	_, err = conn.Do("HSET", userkey, "username", username, "trackable", trackable, "password", hash, "role", role)
 	if err != nil {
-		logger.Fatal(err)
+		logger.Warn(err)
 		return -1, err
 	}
 
 	if trackable {
-		_, err = conn.Do("RPUSH", "trackables", userid)
+		_, err = conn.Do("RPUSH", c.key("trackables"), userid)
 	}
 
 	return userid, nil
 }
 
-func (c *client) StartSession(username string) (int, error) {
+func (c *client) Login(username string, password string) (int, error) {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	userid, err := getUserId(conn, username)
+	userid, err := c.getUserId(conn, username)
 	if err != nil {
-		return -1, err
+		return -1, NewError(ErrUnauthenticated, "Invalid username or password")
 	}
-	userkey := fmt.Sprintf("user:%d", userid)
-
-	sessionid, err := redis.Int(conn.Do("INCR", "next_session_id"))
+	err = c.checkPassword(conn, userid, password)
 	if err != nil {
-		logger.Fatal(err)
 		return -1, err
 	}
-	_, err = redis.Int(conn.Do("HSET", userkey, "currentsession", sessionid))
-	if err != nil {
-		logger.Fatal(err)
+	disabled, err := redis.Bool(conn.Do("HGET", c.key("user:%d", userid), "disabled"))
+	if err != nil && err != redis.ErrNil {
+		logger.Warn(err)
 		return -1, err
 	}
-	sessionskey := fmt.Sprintf("sessions:%d", userid)
-
-	_, err = conn.Do("ZADD", sessionskey, time.Now().Unix(), sessionid)
-	if err != nil {
-		logger.Fatal(err)
-		return -1, err
+	if disabled {
+		return -1, NewError(ErrPermissionDenied, "User %s is disabled", username)
 	}
-	logger.Infof("Start Session %s %s %d", username, sessionskey, sessionid)
 
-	return sessionid, nil
+	return userid, nil
 }
 
-func (c *client) StopSession(username string) error {
+func (c *client) ChangePassword(username string, oldpassword string, newpassword string) error {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	userid, err := getUserId(conn, username)
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	err = c.checkPassword(conn, userid, oldpassword)
+	if err != nil {
+		return err
+	}
+	if newpassword == "" {
+		return InvalidField("newPassword", "A new password is required")
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(newpassword), bcrypt.DefaultCost)
+	if err != nil {
+		return err
+	}
+	userkey := c.key("user:%d", userid)
+
+	_, err = conn.Do("HSET", userkey, "password", hash, "tokensrevokedat", time.Now().Unix())
 	if err != nil {
+		logger.Warn(err)
 		return err
 	}
-	userkey := fmt.Sprintf("user:%d", userid)
 
-	_, err = conn.Do("HDEL", userkey, "currentsession")
+	refreshtokenskey := c.key("refreshtokens:%d", userid)
+	tokens, err := redis.Strings(conn.Do("SMEMBERS", refreshtokenskey))
 	if err != nil {
-		logger.Fatal(err)
+		logger.Warn(err)
 		return err
 	}
-	logger.Infof("Stop Session %s", username)
+	for _, token := range tokens {
+		_, err = conn.Do("DEL", c.key("refresh:%s", token))
+		if err != nil {
+			logger.Warn(err)
+			return err
+		}
+	}
+	_, err = conn.Do("DEL", refreshtokenskey)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Changed password for %s", username)
 
 	return nil
 }
 
-func (c *client) StartTracking(trackeename string, username string) error {
+func (c *client) GetUserName(userid int) (string, error) {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	trackeeid, err := getUserId(conn, trackeename)
+	userkey := c.key("user:%d", userid)
+	username, err := redis.String(conn.Do("HGET", userkey, "username"))
+	if err == redis.ErrNil {
+		return "", NewError(ErrNotFound, "User %d does not exist", userid)
+	}
 	if err != nil {
-		return err
+		logger.Warn(err)
+		return "", err
 	}
-	userkey := fmt.Sprintf("user:%d", trackeeid)
-	trackable, err := redis.Bool(conn.Do("HGET", userkey, "trackable"))
+	return username, nil
+}
 
-	if trackable {
-		trackedkey := fmt.Sprintf("tracked:%d", trackeeid)
-		userid, err := conn.Do("HGET", "users", username)
-		if err != nil {
-			logger.Fatal(err)
-			return err
-		}
+func (c *client) GetUserAccess(userid int) (string, bool, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
 
-		_, err = conn.Do("ZADD", trackedkey, time.Now().Unix(), userid)
-		if err != nil {
-			logger.Fatal(err)
-			return err
-		}
-	} else {
-		return fmt.Errorf("User %s is not trackable", trackeename)
+	userkey := c.key("user:%d", userid)
+	values, err := redis.Values(conn.Do("HMGET", userkey, "username", "role", "disabled"))
+	if err != nil {
+		logger.Warn(err)
+		return "", true, err
 	}
-
-	return nil
+	if values[0] == nil {
+		return "", true, NewError(ErrNotFound, "User %d does not exist", userid)
+	}
+	role, _ := redis.String(values[1], nil)
+	if role == "" {
+		role = RoleUser
+	}
+	disabled, _ := redis.Bool(values[2], nil)
+	return role, disabled, nil
 }
 
-func (c *client) StopTracking(trackeename string, username string) error {
+func (c *client) ListUsers(cursor string, count int) ([]User, string, error) {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	trackeeid, err := getUserId(conn, username)
+	if cursor == "" {
+		cursor = "0"
+	}
+	values, err := redis.Values(conn.Do("HSCAN", c.key("users"), cursor, "COUNT", count))
 	if err != nil {
-		return err
+		logger.Warn(err)
+		return nil, "", err
+	}
+	next, err := redis.String(values[0], nil)
+	if err != nil {
+		return nil, "", err
+	}
+	entries, err := redis.Strings(values[1], nil)
+	if err != nil {
+		return nil, "", err
 	}
-	userkey := fmt.Sprintf("user:%d", trackeeid)
-	trackable, err := redis.Bool(conn.Do("HGET", userkey, "trackable"))
 
-	if trackable {
-		trackedkey := fmt.Sprintf("tracked:%d", trackeeid)
-		userid, err := conn.Do("HGET", "users", username)
+	results := []User{}
+	for i := 0; i+1 < len(entries); i += 2 {
+		var user User
+		user.Id, _ = strconv.Atoi(entries[i+1])
+		user.UserName = entries[i]
+		fields, err := redis.Values(conn.Do("HMGET", c.key("user:%d", user.Id), "role", "trackable", "disabled"))
 		if err != nil {
-			logger.Fatal(err)
-			return err
+			logger.Warn(err)
+			return nil, "", err
 		}
-		_, err = conn.Do("ZREM", trackedkey, userid)
-		if err != nil {
-			logger.Fatal(err)
-			return err
+		user.Role, _ = redis.String(fields[0], nil)
+		if user.Role == "" {
+			user.Role = RoleUser
 		}
-	} else {
-		return fmt.Errorf("User %s is not trackable", trackeename)
+		user.Trackable, _ = redis.Bool(fields[1], nil)
+		user.Disabled, _ = redis.Bool(fields[2], nil)
+		results = append(results, user)
 	}
-
-	return nil
+	if next == "0" {
+		next = ""
+	}
+	return results, next, nil
 }
 
-func (c *client) ReportLocation(username string, longitude float64, latitude float64, timestamp int64) error {
+func (c *client) SetUserRole(username string, role string) error {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	userid, err := getUserId(conn, username)
-	if err != nil {
-		return err
+	if role != RoleUser && role != RoleAdmin && role != RoleSupport {
+		return InvalidField("role", "Unknown role %s", role)
 	}
-	userkey := fmt.Sprintf("user:%d", userid)
-
-	locationid, err := redis.Int(conn.Do("INCR", "next_location_id"))
+	userid, err := c.getUserId(conn, username)
 	if err != nil {
-		logger.Fatal(err)
 		return err
 	}
-	locationkey := fmt.Sprintf("location:%d", locationid)
-
-	//logger.Infof("Location %s %d %f:%f %d", username, locationid, latitude, longitude, timestamp)
-
-	_, err = conn.Do("HSET", locationkey, "latitude", latitude, "longitude", longitude, "timestamp", timestamp)
+	_, err = conn.Do("HSET", c.key("user:%d", userid), "role", role)
 	if err != nil {
-		logger.Fatal(err)
+		logger.Warn(err)
 		return err
 	}
+	logger.Infof("Set role of %s to %s", username, role)
+
+	return nil
+}
 
-	channel := fmt.Sprintf("channel:%s", username)
+func (c *client) SetUserDisabled(username string, disabled bool) error {
+	conn := c.pool.Get()
+	defer conn.Close()
 
-	_, err = conn.Do("PUBLISH", channel, locationkey)
+	userid, err := c.getUserId(conn, username)
 	if err != nil {
-		logger.Fatal(err)
 		return err
 	}
-
-	currentsession, err := redis.Int(conn.Do("HGET", userkey, "currentsession"))
-	if err != nil {
-		logger.Fatal(err)
-		return err
+	userkey := c.key("user:%d", userid)
+	if disabled {
+		_, err = conn.Do("HSET", userkey, "disabled", true, "tokensrevokedat", time.Now().Unix())
+	} else {
+		_, err = conn.Do("HDEL", userkey, "disabled")
 	}
-
-	sessionkey := fmt.Sprintf("session:%d", currentsession)
-
-	_, err = conn.Do("RPUSH", sessionkey, locationid)
 	if err != nil {
-		logger.Fatal(err)
+		logger.Warn(err)
 		return err
 	}
+	logger.Infof("Set disabled of %s to %t", username, disabled)
 
 	return nil
 }
 
-func (c *client) GetTrackables() ([]string, error) {
+// DeleteUser removes a user along with everything stored about them and
+// every relationship they are part of.
+func (c *client) DeleteUser(username string, password string) error {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	trackables, err := redis.Ints(conn.Do("LRANGE", "trackables", 0, -1))
+	userid, err := c.getUserId(conn, username)
 	if err != nil {
-		logger.Fatal(err)
-		return nil, err
+		return err
+	}
+	if err = c.checkPassword(conn, userid, password); err != nil {
+		return err
 	}
-	results := []string{}
 
-	for _, userid := range trackables {
-		userkey := fmt.Sprintf("user:%d", userid)
-		username, err := redis.String(conn.Do("HGET", userkey, "username"))
+	// Relationships where the user is the watcher are only indexed by the
+	// trackee, and only trackable users can have watchers.
+	trackables, err := redis.Ints(conn.Do("LRANGE", c.key("trackables"), 0, -1))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	for _, trackeeid := range trackables {
+		for _, key := range []string{c.key("trackingrequests:%d", trackeeid), c.key("watchers:%d", trackeeid), c.key("tracked:%d", trackeeid)} {
+			_, err = conn.Do("ZREM", key, userid)
+			if err != nil {
+				logger.Warn(err)
+				return err
+			}
+		}
+		_, err = conn.Do("HDEL", c.key("precision:%d", trackeeid), userid)
 		if err != nil {
-			logger.Fatal(err)
-			return nil, err
+			logger.Warn(err)
+			return err
 		}
-		results = append(results, username)
 	}
-	return results, nil
-}
 
-func (c *client) GetSessionIds(username string) ([]SessionId, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
-	userid, err := getUserId(conn, username)
+	// Revoking tracking removes a trackee from the groups of the watcher, so
+	// only the watchers of the user can have them as a member.
+	watchers, err := redis.Ints(conn.Do("ZRANGE", c.key("watchers:%d", userid), 0, -1))
 	if err != nil {
-		return nil, err
+		logger.Warn(err)
+		return err
+	}
+	for _, watcherid := range watchers {
+		if err = c.removeFromGroups(conn, watcherid, username); err != nil {
+			return err
+		}
 	}
 
-	sessionskey := fmt.Sprintf("sessions:%d", userid)
-
-	sessions, err := redis.Int64s(conn.Do("ZRANGE", sessionskey, 0, -1))
+	keys := []string{}
+	groupnames, err := redis.Strings(conn.Do("HKEYS", c.key("groups:%d", userid)))
 	if err != nil {
-		logger.Fatal(err)
-		return nil, err
+		logger.Warn(err)
+		return err
 	}
-
-	results := []SessionId{}
-
-	for _, id := range sessions {
-		timestamp, err := redis.Int64(conn.Do("ZSCORE", sessionskey, id))
+	for _, groupname := range groupnames {
+		keys = append(keys, c.key("group:%d:%s", userid, groupname))
+		_, err = conn.Do("PUBLISH", c.key("groupchannel:%d:%s", userid, groupname), "delete:")
 		if err != nil {
-			logger.Fatal(err)
-			return nil, err
+			logger.Warn(err)
+			return err
 		}
-		logger.Infof("Location: %+v", SessionId{id, timestamp})
-
-		results = append(results, SessionId{id, timestamp})
 	}
-	return results, nil
-}
-
-func (c *client) GetSessionData(sessionid int64) ([]TrackingData, error) {
-	conn := c.pool.Get()
-	defer conn.Close()
 
-	sessionkey := fmt.Sprintf("session:%d", sessionid)
-	locations, err := redis.Ints(conn.Do("LRANGE", sessionkey, 0, -1))
+	sessions, err := redis.Int64s(conn.Do("ZRANGE", c.key("sessions:%d", userid), 0, -1))
 	if err != nil {
-		logger.Fatal(err)
-		return nil, err
+		logger.Warn(err)
+		return err
 	}
-	results := []TrackingData{}
-	for _, locationid := range locations {
-		locationkey := fmt.Sprintf("location:%d", locationid)
-		location, err := redis.Float64s(conn.Do("HMGET", locationkey, "latitude", "longitude", "timestamp"))
-		if err != nil {
-			logger.Fatal(err)
-			return nil, err
+	for _, sessionid := range sessions {
+		if _, err = c.purgeLocations(conn, sessionid); err != nil {
+			return err
+		}
+		if err = c.deleteSession(conn, userid, sessionid); err != nil {
+			return err
 		}
-		results = append(results, TrackingData{int64(locationid), location[1], location[0], int64(location[2])})
 	}
-	return results, nil
-}
 
-func (c *client) MonitorLocation(trackeename string, username string, cb MonitorFunc) error {
-	conn := c.pool.Get()
-	defer conn.Close()
+	for format, setkey := range map[string]string{
+		"refresh:%s": c.key("refreshtokens:%d", userid),
+		"apikey:%s":  c.key("apikeys:%d", userid),
+		"share:%s":   c.key("shares:%d", userid),
+		"zone:%s":    c.key("zones:%d", userid),
+	} {
+		members, err := redis.Strings(conn.Do("SMEMBERS", setkey))
+		if err != nil {
+			logger.Warn(err)
+			return err
+		}
+		for _, member := range members {
+			keys = append(keys, c.key(format, member))
+		}
+		keys = append(keys, setkey)
+	}
 
-	channel := fmt.Sprintf("channel:%s", trackeename)
+	keys = append(keys,
+		c.key("user:%d", userid),
+		c.key("audit:%d", userid),
+		c.key("sessions:%d", userid),
+		c.key("trackingrequests:%d", userid),
+		c.key("watchers:%d", userid),
+		c.key("tracked:%d", userid),
+		c.key("precision:%d", userid),
+		c.key("groups:%d", userid),
+		c.key("datakeys:%d", userid))
+	_, err = conn.Do("DEL", redis.Args{}.AddFlat(keys)...)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("LREM", c.key("trackables"), 0, userid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
 
-	psc := redis.PubSubConn{Conn: conn}
-	psc.Subscribe(channel)
-	for {
-		switch v := psc.Receive().(type) {
-		case redis.Message:
-			locationkey := string(v.Data)
-			logger.Infof("%s: message: %s", v.Channel, locationkey)
-			cb(locationkey)
-		case redis.Subscription:
-			logger.Infof("%s: %s %d\n", v.Channel, v.Kind, v.Count)
-		case error:
-			return v
-		}
+	// Removed last so that a deletion that fails part way can be retried
+	_, err = conn.Do("HDEL", c.key("users"), username)
+	if err != nil {
+		logger.Warn(err)
+		return err
 	}
+	logger.Infof("Deleted user %s", username)
+
+	return nil
 }
 
-func (c *client) GetLocation(locationkey string) (TrackingData, error) {
+func (c *client) ExportUser(username string) (UserExport, error) {
 	conn := c.pool.Get()
 	defer conn.Close()
 
-	location, err := redis.Float64s(conn.Do("HMGET", locationkey, "latitude", "longitude", "timestamp"))
-
+	userid, err := c.getUserId(conn, username)
 	if err != nil {
-		logger.Fatal(err)
-		return TrackingData{}, err
+		return UserExport{}, err
 	}
+	values, err := redis.Values(conn.Do("HMGET", c.key("user:%d", userid), "role", "trackable"))
+	if err != nil {
+		logger.Warn(err)
+		return UserExport{}, err
+	}
+	export := UserExport{Org: c.org, UserName: username, Sessions: []SessionExport{}, Watching: []Watcher{}}
+	export.Role, _ = redis.String(values[0], nil)
+	if export.Role == "" {
+		export.Role = RoleUser
+	}
+	export.Trackable, _ = redis.Bool(values[1], nil)
 
-	return TrackingData{int64(0), location[1], location[0], int64(location[2])}, nil
-}
-
-func getUserId(conn redis.Conn, username string) (int, error) {
-	id, err := conn.Do("HGET", "users", username)
+	export.ApiKeys, err = c.GetApiKeys(username)
 	if err != nil {
-		logger.Fatal(err)
-		return -1, err
+		return UserExport{}, err
 	}
-	if id == nil {
-		return -1, fmt.Errorf("Username %s does not exist", username)
+	sessionids, err := c.GetSessionIds(username)
+	if err != nil {
+		return UserExport{}, err
 	}
-	userid, err := redis.Int(id, nil)
-	return userid, nil
+	for _, sessionid := range sessionids {
+		summary, err := c.GetSessionSummary(sessionid.Id)
+		if err != nil {
+			return UserExport{}, err
+		}
+		data, err := c.GetSessionData(sessionid.Id)
+		if err != nil {
+			return UserExport{}, err
+		}
+		export.Sessions = append(export.Sessions, SessionExport{sessionid.Id, sessionid.Timestamp, summary, data})
+	}
+	export.PendingWatchers, export.Watchers, err = c.GetWatchers(username)
+	if err != nil {
+		return UserExport{}, err
+	}
+
+	trackables, err := redis.Ints(conn.Do("LRANGE", c.key("trackables"), 0, -1))
+	if err != nil {
+		logger.Warn(err)
+		return UserExport{}, err
+	}
+	for _, trackeeid := range trackables {
+		timestamp, err := redis.Int64(conn.Do("ZSCORE", c.key("watchers:%d", trackeeid), userid))
+		if err == redis.ErrNil {
+			continue
+		}
+		if err != nil {
+			logger.Warn(err)
+			return UserExport{}, err
+		}
+		trackeename, err := redis.String(conn.Do("HGET", c.key("user:%d", trackeeid), "username"))
+		if err != nil {
+			logger.Warn(err)
+			return UserExport{}, err
+		}
+		precision, err := c.getPrecision(conn, trackeeid, userid)
+		if err != nil {
+			return UserExport{}, err
+		}
+		export.Watching = append(export.Watching, Watcher{trackeename, timestamp, precision})
+	}
+
+	export.Groups, err = c.GetGroups(username)
+	if err != nil {
+		return UserExport{}, err
+	}
+	export.ShareLinks, err = c.GetShareLinks(username)
+	if err != nil {
+		return UserExport{}, err
+	}
+	export.PrivacyZones, err = c.GetPrivacyZones(username)
+	if err != nil {
+		return UserExport{}, err
+	}
+	export.AuditLog, err = c.GetAuditLog(username, 0, 0)
+	if err != nil {
+		return UserExport{}, err
+	}
+	logger.Infof("Exported data for %s", username)
+
+	return export, nil
+}
+
+func (c *client) StoreRefreshToken(token string, userid int, ttl time.Duration) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	tokenhash := hashToken(token)
+	_, err := conn.Do("SET", c.key("refresh:%s", tokenhash), userid, "EX", int64(ttl.Seconds()))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("SADD", c.key("refreshtokens:%d", userid), tokenhash)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) TakeRefreshToken(token string) (int, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	tokenhash := hashToken(token)
+	refreshkey := c.key("refresh:%s", tokenhash)
+
+	conn.Send("MULTI")
+	conn.Send("GET", refreshkey)
+	conn.Send("DEL", refreshkey)
+	values, err := redis.Values(conn.Do("EXEC"))
+	if err != nil {
+		logger.Warn(err)
+		return -1, err
+	}
+	if values[0] == nil {
+		return -1, NewError(ErrUnauthenticated, "Invalid or expired refresh token")
+	}
+	userid, err := redis.Int(values[0], nil)
+	if err != nil {
+		return -1, err
+	}
+	_, err = conn.Do("SREM", c.key("refreshtokens:%d", userid), tokenhash)
+	if err != nil {
+		logger.Warn(err)
+		return -1, err
+	}
+	return userid, nil
+}
+
+func (c *client) RevokeAccessToken(tokenid string, ttl time.Duration) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if ttl <= 0 {
+		return nil
+	}
+	_, err := conn.Do("SET", c.key("revoked:%s", tokenid), 1, "EX", int64(ttl.Seconds())+1)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) IsAccessTokenRevoked(tokenid string, userid int, issuedat int64) (bool, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	revoked, err := redis.Bool(conn.Do("EXISTS", c.key("revoked:%s", tokenid)))
+	if err != nil {
+		logger.Warn(err)
+		return true, err
+	}
+	if revoked {
+		return true, nil
+	}
+
+	userkey := c.key("user:%d", userid)
+	revokedat, err := redis.Int64(conn.Do("HGET", userkey, "tokensrevokedat"))
+	if err == redis.ErrNil {
+		return false, nil
+	}
+	if err != nil {
+		logger.Warn(err)
+		return true, err
+	}
+	return issuedat < revokedat, nil
+}
+
+func (c *client) StoreApiKey(username string, keyid string, secret string, label string) (ApiKey, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return ApiKey{}, err
+	}
+	apikey := ApiKey{Id: keyid, Label: label, CreatedAt: time.Now().Unix()}
+	apikeykey := c.key("apikey:%s", keyid)
+
+	_, err = conn.Do("HSET", apikeykey, "userid", userid, "hash", hashToken(secret), "label", label, "createdat", apikey.CreatedAt)
+	if err != nil {
+		logger.Warn(err)
+		return ApiKey{}, err
+	}
+	_, err = conn.Do("SADD", c.key("apikeys:%d", userid), keyid)
+	if err != nil {
+		logger.Warn(err)
+		return ApiKey{}, err
+	}
+	logger.Infof("Created API key %s for %s", keyid, username)
+
+	return apikey, nil
+}
+
+func (c *client) GetApiKeys(username string) ([]ApiKey, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return nil, err
+	}
+	keyids, err := redis.Strings(conn.Do("SMEMBERS", c.key("apikeys:%d", userid)))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+
+	results := []ApiKey{}
+	for _, keyid := range keyids {
+		values, err := redis.Values(conn.Do("HMGET", c.key("apikey:%s", keyid), "label", "createdat", "lastused"))
+		if err != nil {
+			logger.Warn(err)
+			return nil, err
+		}
+		label, _ := redis.String(values[0], nil)
+		createdat, _ := redis.Int64(values[1], nil)
+		lastused, _ := redis.Int64(values[2], nil)
+		results = append(results, ApiKey{keyid, label, createdat, lastused})
+	}
+	return results, nil
+}
+
+func (c *client) LabelApiKey(username string, keyid string, label string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	err = c.checkApiKeyOwner(conn, userid, keyid)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("HSET", c.key("apikey:%s", keyid), "label", label)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) RevokeApiKey(username string, keyid string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	err = c.checkApiKeyOwner(conn, userid, keyid)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("DEL", c.key("apikey:%s", keyid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("SREM", c.key("apikeys:%d", userid), keyid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Revoked API key %s for %s", keyid, username)
+
+	return nil
+}
+
+func (c *client) CheckApiKey(keyid string, secret string) (int, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	apikeykey := c.key("apikey:%s", keyid)
+	values, err := redis.Values(conn.Do("HMGET", apikeykey, "userid", "hash"))
+	if err != nil {
+		logger.Warn(err)
+		return -1, err
+	}
+	if values[0] == nil {
+		return -1, NewError(ErrUnauthenticated, "Invalid API key")
+	}
+	var userid int
+	var hash string
+	_, err = redis.Scan(values, &userid, &hash)
+	if err != nil {
+		return -1, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(hashToken(secret))) != 1 {
+		return -1, NewError(ErrUnauthenticated, "Invalid API key")
+	}
+	_, err = conn.Do("HSET", apikeykey, "lastused", time.Now().Unix())
+	if err != nil {
+		logger.Warn(err)
+		return -1, err
+	}
+	return userid, nil
+}
+
+func (c *client) StartSession(username string) (string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return "", err
+	}
+	userkey := c.key("user:%d", userid)
+
+	sessionid, err := redis.Int64(conn.Do("INCR", c.key("next_session_id")))
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	_, err = redis.Int(conn.Do("HSET", userkey, "currentsession", sessionid))
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	sessionskey := c.key("sessions:%d", userid)
+
+	_, err = conn.Do("ZADD", sessionskey, time.Now().Unix(), sessionid)
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	_, err = conn.Do("HSET", c.key("sessionowners"), sessionid, userid)
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	sessionkey, err := c.newSessionKey(conn, sessionid)
+	if err != nil {
+		return "", err
+	}
+	logger.Infof("Start Session %s %s %d", username, sessionskey, sessionid)
+
+	return sessionkey, nil
+}
+
+func (c *client) StopSession(username string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	userkey := c.key("user:%d", userid)
+
+	_, err = conn.Do("HDEL", userkey, "currentsession")
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Stop Session %s", username)
+
+	return nil
+}
+
+func (c *client) StartTracking(trackeename string, username string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return err
+	}
+	approved, err := c.isWatcher(conn, trackeeid, userid)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return NewError(ErrPermissionDenied, "User %s is not approved to track %s", username, trackeename)
+	}
+
+	trackedkey := c.key("tracked:%d", trackeeid)
+	_, err = conn.Do("ZADD", trackedkey, time.Now().Unix(), userid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) StopTracking(trackeename string, username string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return err
+	}
+
+	trackedkey := c.key("tracked:%d", trackeeid)
+	_, err = conn.Do("ZREM", trackedkey, userid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) RequestTracking(trackeename string, username string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return err
+	}
+	if trackeeid == userid {
+		return InvalidField("trackeeName", "User %s cannot request to track themselves", username)
+	}
+	userkey := c.key("user:%d", trackeeid)
+	trackable, err := redis.Bool(conn.Do("HGET", userkey, "trackable"))
+	if err != nil && err != redis.ErrNil {
+		logger.Warn(err)
+		return err
+	}
+	if !trackable {
+		return NewError(ErrFailedPrecondition, "User %s is not trackable", trackeename)
+	}
+	approved, err := c.isWatcher(conn, trackeeid, userid)
+	if err != nil {
+		return err
+	}
+	if approved {
+		return NewError(ErrAlreadyExists, "User %s is already approved to track %s", username, trackeename)
+	}
+
+	requestskey := c.key("trackingrequests:%d", trackeeid)
+	_, err = conn.Do("ZADD", requestskey, time.Now().Unix(), userid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Tracking requested %s %s", trackeename, username)
+
+	return nil
+}
+
+func (c *client) ApproveTracking(trackeename string, username string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return err
+	}
+	requestskey := c.key("trackingrequests:%d", trackeeid)
+	removed, err := redis.Int(conn.Do("ZREM", requestskey, userid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if removed == 0 {
+		return NewError(ErrNotFound, "User %s has not requested to track %s", username, trackeename)
+	}
+
+	watcherskey := c.key("watchers:%d", trackeeid)
+	_, err = conn.Do("ZADD", watcherskey, time.Now().Unix(), userid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Tracking approved %s %s", trackeename, username)
+
+	return nil
+}
+
+func (c *client) DenyTracking(trackeename string, username string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return err
+	}
+	requestskey := c.key("trackingrequests:%d", trackeeid)
+	removed, err := redis.Int(conn.Do("ZREM", requestskey, userid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if removed == 0 {
+		return NewError(ErrNotFound, "User %s has not requested to track %s", username, trackeename)
+	}
+	logger.Infof("Tracking denied %s %s", trackeename, username)
+
+	return nil
+}
+
+func (c *client) RevokeTracking(trackeename string, username string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return err
+	}
+	watcherskey := c.key("watchers:%d", trackeeid)
+	removed, err := redis.Int(conn.Do("ZREM", watcherskey, userid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if removed == 0 {
+		return NewError(ErrNotFound, "User %s is not approved to track %s", username, trackeename)
+	}
+	trackedkey := c.key("tracked:%d", trackeeid)
+	_, err = conn.Do("ZREM", trackedkey, userid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("HDEL", c.key("precision:%d", trackeeid), userid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if err = c.removeFromGroups(conn, userid, trackeename); err != nil {
+		return err
+	}
+	logger.Infof("Tracking revoked %s %s", trackeename, username)
+
+	return nil
+}
+
+// removeFromGroups removes a trackee from every group of a watcher, telling
+// those following the groups.
+func (c *client) removeFromGroups(conn redis.Conn, watcherid int, trackeename string) error {
+	groupnames, err := redis.Strings(conn.Do("HKEYS", c.key("groups:%d", watcherid)))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	for _, groupname := range groupnames {
+		removed, err := redis.Int(conn.Do("SREM", c.key("group:%d:%s", watcherid, groupname), trackeename))
+		if err != nil {
+			logger.Warn(err)
+			return err
+		}
+		if removed == 0 {
+			continue
+		}
+		_, err = conn.Do("PUBLISH", c.key("groupchannel:%d:%s", watcherid, groupname), "remove:"+trackeename)
+		if err != nil {
+			logger.Warn(err)
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *client) GetWatchers(username string) ([]Watcher, []Watcher, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return nil, nil, err
+	}
+	pending, err := c.getWatcherSet(conn, c.key("trackingrequests:%d", userid))
+	if err != nil {
+		return nil, nil, err
+	}
+	approved, err := c.getWatcherSet(conn, c.key("watchers:%d", userid))
+	if err != nil {
+		return nil, nil, err
+	}
+	for i, w := range approved {
+		watcherid, err := c.getUserId(conn, w.UserName)
+		if err != nil {
+			return nil, nil, err
+		}
+		approved[i].Precision, err = c.getPrecision(conn, userid, watcherid)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return pending, approved, nil
+}
+
+func (c *client) IsWatcher(trackeename string, username string) (bool, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return false, err
+	}
+	return c.isWatcher(conn, trackeeid, userid)
+}
+
+func (c *client) GetTrackingPrecision(trackeename string, username string) (string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return "", err
+	}
+	return c.getPrecision(conn, trackeeid, userid)
+}
+
+func (c *client) SetTrackingPrecision(trackeename string, username string, precision string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	switch precision {
+	case PrecisionExact, Precision100m, Precision1km, Precision10km:
+	default:
+		return InvalidField("precision", "Unknown precision %s", precision)
+	}
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return err
+	}
+	approved, err := c.isWatcher(conn, trackeeid, userid)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return NewError(ErrFailedPrecondition, "User %s is not approved to track %s", username, trackeename)
+	}
+	precisionkey := c.key("precision:%d", trackeeid)
+	if precision == PrecisionExact {
+		_, err = conn.Do("HDEL", precisionkey, userid)
+	} else {
+		_, err = conn.Do("HSET", precisionkey, userid, precision)
+	}
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Tracking precision %s %s %s", trackeename, username, precision)
+
+	return nil
+}
+
+func (c *client) ReportLocation(username string, longitude float64, latitude float64, timestamp int64) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	userkey := c.key("user:%d", userid)
+
+	// locations are only recorded in a session, devices such as those using
+	// an API key are rejected before anything is written until one starts
+	currentsession, err := redis.Int(conn.Do("HGET", userkey, "currentsession"))
+	if err == redis.ErrNil {
+		return NewError(ErrFailedPrecondition, "No active session")
+	}
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+
+	pause, err := c.getPause(conn, userid)
+	if err != nil {
+		return err
+	}
+	paused := pause.Active(time.Now())
+	if pause.Since != 0 && !paused {
+		// the pause has run out
+		if err = c.endPause(conn, userid, username); err != nil {
+			return err
+		}
+	}
+
+	locationid, err := redis.Int(conn.Do("INCR", c.key("next_location_id")))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	locationkey := c.key("location:%d", locationid)
+
+	//logger.Infof("Location %s %d %f:%f %d", username, locationid, latitude, longitude, timestamp)
+
+	fields := redis.Args{}.Add(locationkey, "latitude", latitude, "longitude", longitude, "timestamp", timestamp)
+	if c.keyring != nil {
+		version, aead, err := c.currentDataKey(conn, userid)
+		if err != nil {
+			return err
+		}
+		sealed, err := seal(aead, encodeCoordinates(latitude, longitude), []byte(locationkey))
+		if err != nil {
+			return err
+		}
+		fields = redis.Args{}.Add(locationkey, "coordinates", sealed, "owner", userid, "keyversion", version, "timestamp", timestamp)
+	}
+	_, err = conn.Do("HSET", fields...)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+
+	// locations are still recorded while paused, just not published
+	if !paused {
+		_, err = conn.Do("PUBLISH", c.key("channel:%s", username), locationkey)
+		if err != nil {
+			logger.Warn(err)
+			return err
+		}
+	}
+
+	sessionkey := c.key("session:%d", currentsession)
+
+	_, err = conn.Do("RPUSH", sessionkey, locationid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+
+	return nil
+}
+
+func (c *client) PauseSharing(username string, until int64) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if until != 0 && until <= time.Now().Unix() {
+		return InvalidField("until", "Pause must end in the future")
+	}
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	userkey := c.key("user:%d", userid)
+	lastlocationid, err := redis.Int64(conn.Do("GET", c.key("next_location_id")))
+	if err != nil && err != redis.ErrNil {
+		logger.Warn(err)
+		return err
+	}
+	// extending a pause keeps hiding what was reported since it began
+	pause, err := c.getPause(conn, userid)
+	if err != nil {
+		return err
+	}
+	if !pause.Active(time.Now()) {
+		pause = Pause{Since: time.Now().Unix(), LastLocationId: lastlocationid}
+	}
+	pause.Until = until
+	_, err = conn.Do("HSET", userkey, "pausedat", pause.Since, "pauseduntil", pause.Until, "pausedafter", pause.LastLocationId)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("PUBLISH", c.key("channel:%s", username), fmt.Sprintf("status:%s:%d", StatusPaused, until))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Paused sharing %s until %d", username, until)
+
+	return nil
+}
+
+func (c *client) ResumeSharing(username string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	pause, err := c.getPause(conn, userid)
+	if err != nil {
+		return err
+	}
+	if pause.Since == 0 {
+		return NewError(ErrFailedPrecondition, "User %s has not paused sharing", username)
+	}
+	return c.endPause(conn, userid, username)
+}
+
+// GetPause returns the pause of username, which is zero if they have never
+// paused or have resumed since.
+func (c *client) GetPause(username string) (Pause, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return Pause{}, err
+	}
+	return c.getPause(conn, userid)
+}
+
+func (c *client) GetTrackables() ([]string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackables, err := redis.Ints(conn.Do("LRANGE", c.key("trackables"), 0, -1))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+	results := []string{}
+
+	for _, userid := range trackables {
+		userkey := c.key("user:%d", userid)
+		username, err := redis.String(conn.Do("HGET", userkey, "username"))
+		if err != nil {
+			logger.Warn(err)
+			return nil, err
+		}
+		results = append(results, username)
+	}
+	return results, nil
+}
+
+func (c *client) GetSessionIds(username string) ([]SessionId, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return nil, err
+	}
+
+	sessionskey := c.key("sessions:%d", userid)
+
+	sessions, err := redis.Int64s(conn.Do("ZRANGE", sessionskey, 0, -1))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+
+	results := []SessionId{}
+
+	for _, id := range sessions {
+		timestamp, err := redis.Int64(conn.Do("ZSCORE", sessionskey, id))
+		if err != nil {
+			logger.Warn(err)
+			return nil, err
+		}
+		sessionkey, err := c.getSessionKey(conn, id)
+		if err != nil {
+			return nil, err
+		}
+		results = append(results, SessionId{sessionkey, timestamp})
+	}
+	return results, nil
+}
+
+func (c *client) GetSessionData(sessionid string) ([]TrackingData, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	id, err := c.resolveSessionKey(conn, sessionid)
+	if err != nil {
+		return nil, err
+	}
+	return c.getSessionPoints(conn, id)
+}
+
+func (c *client) GetSessionOwner(sessionid string) (string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	id, err := c.resolveSessionKey(conn, sessionid)
+	if err != nil {
+		return "", err
+	}
+	userid, err := redis.Int(conn.Do("HGET", c.key("sessionowners"), id))
+	if err == redis.ErrNil {
+		return "", NewError(ErrNotFound, "Session %s does not exist", sessionid)
+	}
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	username, err := redis.String(conn.Do("HGET", c.key("user:%d", userid), "username"))
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	return username, nil
+}
+
+func (c *client) GetCurrentSession(username string) (string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return "", err
+	}
+	userkey := c.key("user:%d", userid)
+	sessionid, err := redis.Int64(conn.Do("HGET", userkey, "currentsession"))
+	if err == redis.ErrNil {
+		return "", nil
+	}
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	return c.getSessionKey(conn, sessionid)
+}
+
+func (c *client) GetSessionSummary(sessionid string) (SessionSummary, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	id, err := c.resolveSessionKey(conn, sessionid)
+	if err != nil {
+		return SessionSummary{}, err
+	}
+	summary, stored, err := c.getSessionSummary(conn, id)
+	if err != nil {
+		return SessionSummary{}, err
+	}
+	if !stored {
+		// only sessions that have been compacted or purged keep a summary
+		points, err := c.getSessionPoints(conn, id)
+		if err != nil {
+			return SessionSummary{}, err
+		}
+		summary = summarize(points)
+	}
+	summary.Id = sessionid
+	return summary, nil
+}
+
+// GetRetentionPolicy returns the policy set for username, or for the
+// organization when username is empty. Unset durations are zero.
+func (c *client) GetRetentionPolicy(username string) (RetentionPolicy, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if username == "" {
+		return c.getRetentionPolicy(conn, c.key("retention"), "locations", "sessions")
+	}
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return RetentionPolicy{}, err
+	}
+	return c.getRetentionPolicy(conn, c.key("user:%d", userid), "retentionlocations", "retentionsessions")
+}
+
+func (c *client) SetRetentionPolicy(username string, policy RetentionPolicy) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	for _, d := range []time.Duration{policy.Locations, policy.Sessions} {
+		if d < 0 && d != RetainForever {
+			return InvalidField("policy", "Invalid retention period %s", d)
+		}
+	}
+	key, fields := c.key("retention"), []string{"locations", "sessions"}
+	if username != "" {
+		userid, err := c.getUserId(conn, username)
+		if err != nil {
+			return err
+		}
+		key, fields = c.key("user:%d", userid), []string{"retentionlocations", "retentionsessions"}
+	}
+	for i, d := range []time.Duration{policy.Locations, policy.Sessions} {
+		var err error
+		switch {
+		case d == 0:
+			_, err = conn.Do("HDEL", key, fields[i])
+		case d == RetainForever:
+			_, err = conn.Do("HSET", key, fields[i], -1)
+		default:
+			_, err = conn.Do("HSET", key, fields[i], int64(d/time.Second))
+		}
+		if err != nil {
+			logger.Warn(err)
+			return err
+		}
+	}
+	logger.Infof("Set retention policy %+v for '%s'", policy, username)
+
+	return nil
+}
+
+// PurgeExpired applies the retention policies to the users in one batch of
+// a scan, returning the cursor for the next batch or "" when done. Policies
+// set on a user take precedence over the organization's, which take
+// precedence over defaults.
+func (c *client) PurgeExpired(defaults RetentionPolicy, cursor string, count int) (PurgeStats, string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	orgpolicy, err := c.getRetentionPolicy(conn, c.key("retention"), "locations", "sessions")
+	if err != nil {
+		return PurgeStats{}, "", err
+	}
+	orgpolicy = inheritRetention(orgpolicy, defaults)
+
+	userids, next, err := c.scanUsers(conn, cursor, count)
+	if err != nil {
+		return PurgeStats{}, "", err
+	}
+
+	stats := PurgeStats{}
+	for _, userid := range userids {
+		userpolicy, err := c.getRetentionPolicy(conn, c.key("user:%d", userid), "retentionlocations", "retentionsessions")
+		if err != nil {
+			return stats, "", err
+		}
+		purged, err := c.purgeUser(conn, userid, inheritRetention(userpolicy, orgpolicy))
+		stats.Sessions += purged.Sessions
+		stats.Locations += purged.Locations
+		if err != nil {
+			return stats, "", err
+		}
+	}
+	return stats, next, nil
+}
+
+// CompactSessions reduces the resolution of sessions that started more than
+// olderthan ago for the users in one batch of a scan, returning the cursor
+// for the next batch or "" when done. The summary of a session is taken
+// from the raw points before they are dropped.
+func (c *client) CompactSessions(olderthan time.Duration, options CompactionOptions, cursor string, count int) (CompactionStats, string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if options.Mode != CompactByTime && options.Mode != CompactByDistance {
+		return CompactionStats{}, "", InvalidField("mode", "Unknown compaction mode %s", options.Mode)
+	}
+	userids, next, err := c.scanUsers(conn, cursor, count)
+	if err != nil {
+		return CompactionStats{}, "", err
+	}
+
+	cutoff := time.Now().Add(-olderthan).Unix()
+	stats := CompactionStats{}
+	for _, userid := range userids {
+		compacted, err := c.compactUser(conn, userid, cutoff, options)
+		stats.Sessions += compacted.Sessions
+		stats.Locations += compacted.Locations
+		if err != nil {
+			return stats, "", err
+		}
+	}
+	return stats, next, nil
+}
+
+// RotateKeys gives the users in one batch of a scan a new data key and
+// re-encrypts their locations with it, returning the cursor for the next
+// batch or "" when done. Locations stored in plaintext are encrypted too.
+// Earlier data keys are kept for locations reported while the rotation ran,
+// but are rewrapped so that old master keys can be retired once every
+// organization has been rotated.
+func (c *client) RotateKeys(cursor string, count int) (RotationStats, string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if c.keyring == nil {
+		return RotationStats{}, "", NewError(ErrFailedPrecondition, "No encryption key is configured")
+	}
+	userids, next, err := c.scanUsers(conn, cursor, count)
+	if err != nil {
+		return RotationStats{}, "", err
+	}
+
+	stats := RotationStats{}
+	for _, userid := range userids {
+		rotated, err := c.rotateUser(conn, userid)
+		stats.Locations += rotated
+		if err != nil {
+			return stats, "", err
+		}
+		stats.Users++
+	}
+	return stats, next, nil
+}
+
+func (c *client) StoreShareLink(username string, shareid string, secret string, sessionid string, expiresat int64) (ShareLink, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return ShareLink{}, err
+	}
+	sharelink := ShareLink{Id: shareid, Org: c.org, UserName: username, SessionId: sessionid, CreatedAt: time.Now().Unix(), ExpiresAt: expiresat}
+	sharekey := c.key("share:%s", shareid)
+
+	_, err = conn.Do("HSET", sharekey, "userid", userid, "hash", hashToken(secret), "sessionid", sessionid, "createdat", sharelink.CreatedAt, "expiresat", expiresat)
+	if err != nil {
+		logger.Warn(err)
+		return ShareLink{}, err
+	}
+	_, err = conn.Do("EXPIREAT", sharekey, expiresat)
+	if err != nil {
+		logger.Warn(err)
+		return ShareLink{}, err
+	}
+	_, err = conn.Do("SADD", c.key("shares:%d", userid), shareid)
+	if err != nil {
+		logger.Warn(err)
+		return ShareLink{}, err
+	}
+	logger.Infof("Created share link %s for %s", shareid, username)
+
+	return sharelink, nil
+}
+
+func (c *client) GetShareLinks(username string) ([]ShareLink, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return nil, err
+	}
+	shareskey := c.key("shares:%d", userid)
+	shareids, err := redis.Strings(conn.Do("SMEMBERS", shareskey))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+
+	results := []ShareLink{}
+	for _, shareid := range shareids {
+		values, err := redis.Values(conn.Do("HMGET", c.key("share:%s", shareid), "sessionid", "createdat", "expiresat"))
+		if err != nil {
+			logger.Warn(err)
+			return nil, err
+		}
+		if values[2] == nil {
+			// expired, forget about it
+			conn.Do("SREM", shareskey, shareid)
+			continue
+		}
+		sessionid, _ := redis.String(values[0], nil)
+		createdat, _ := redis.Int64(values[1], nil)
+		expiresat, _ := redis.Int64(values[2], nil)
+		results = append(results, ShareLink{shareid, c.org, username, sessionid, createdat, expiresat})
+	}
+	return results, nil
+}
+
+func (c *client) RevokeShareLink(username string, shareid string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	removed, err := redis.Int(conn.Do("SREM", c.key("shares:%d", userid), shareid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if removed == 0 {
+		return NewError(ErrNotFound, "Share link %s does not exist", shareid)
+	}
+	_, err = conn.Do("DEL", c.key("share:%s", shareid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Revoked share link %s for %s", shareid, username)
+
+	return nil
+}
+
+func (c *client) CheckShareLink(shareid string, secret string) (ShareLink, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	values, err := redis.Values(conn.Do("HMGET", c.key("share:%s", shareid), "userid", "hash", "sessionid", "createdat", "expiresat"))
+	if err != nil {
+		logger.Warn(err)
+		return ShareLink{}, err
+	}
+	if values[0] == nil {
+		return ShareLink{}, NewError(ErrUnauthenticated, "Invalid or expired share link")
+	}
+	var userid int
+	var hash string
+	var sharelink ShareLink
+	_, err = redis.Scan(values, &userid, &hash, &sharelink.SessionId, &sharelink.CreatedAt, &sharelink.ExpiresAt)
+	if err != nil {
+		return ShareLink{}, err
+	}
+	if subtle.ConstantTimeCompare([]byte(hash), []byte(hashToken(secret))) != 1 {
+		return ShareLink{}, NewError(ErrUnauthenticated, "Invalid or expired share link")
+	}
+	if time.Now().Unix() >= sharelink.ExpiresAt {
+		return ShareLink{}, NewError(ErrUnauthenticated, "Invalid or expired share link")
+	}
+	sharelink.Id = shareid
+	sharelink.Org = c.org
+	sharelink.UserName, err = redis.String(conn.Do("HGET", c.key("user:%d", userid), "username"))
+	if err != nil {
+		logger.Warn(err)
+		return ShareLink{}, err
+	}
+	return sharelink, nil
+}
+
+func (c *client) CreateGroup(username string, groupname string) (Group, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if !groupNamePattern.MatchString(groupname) {
+		return Group{}, InvalidField("groupName", "Invalid group name %s", groupname)
+	}
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return Group{}, err
+	}
+	group := Group{Name: groupname, Members: []string{}, CreatedAt: time.Now().Unix()}
+	created, err := redis.Int(conn.Do("HSETNX", c.key("groups:%d", userid), groupname, group.CreatedAt))
+	if err != nil {
+		logger.Warn(err)
+		return Group{}, err
+	}
+	if created == 0 {
+		return Group{}, NewError(ErrAlreadyExists, "Group %s already exists", groupname)
+	}
+	logger.Infof("Created group %s for %s", groupname, username)
+
+	return group, nil
+}
+
+func (c *client) DeleteGroup(username string, groupname string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	removed, err := redis.Int(conn.Do("HDEL", c.key("groups:%d", userid), groupname))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if removed == 0 {
+		return NewError(ErrNotFound, "Group %s does not exist", groupname)
+	}
+	_, err = conn.Do("DEL", c.key("group:%d:%s", userid, groupname))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("PUBLISH", c.key("groupchannel:%d:%s", userid, groupname), "delete:")
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Deleted group %s for %s", groupname, username)
+
+	return nil
+}
+
+func (c *client) GetGroups(username string) ([]Group, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return nil, err
+	}
+	values, err := redis.StringMap(conn.Do("HGETALL", c.key("groups:%d", userid)))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+
+	results := []Group{}
+	for groupname, createdat := range values {
+		members, err := c.getGroupMembers(conn, userid, groupname)
+		if err != nil {
+			return nil, err
+		}
+		timestamp, _ := strconv.ParseInt(createdat, 10, 64)
+		results = append(results, Group{groupname, members, timestamp})
+	}
+	return results, nil
+}
+
+func (c *client) AddGroupMember(username string, groupname string, trackeename string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	trackeeid, userid, err := c.getTrackeeAndUserIds(conn, trackeename, username)
+	if err != nil {
+		return err
+	}
+	if err = c.checkGroup(conn, userid, groupname); err != nil {
+		return err
+	}
+	approved, err := c.isWatcher(conn, trackeeid, userid)
+	if err != nil {
+		return err
+	}
+	if !approved {
+		return NewError(ErrFailedPrecondition, "User %s is not approved to track %s", username, trackeename)
+	}
+	_, err = conn.Do("SADD", c.key("group:%d:%s", userid, groupname), trackeename)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("PUBLISH", c.key("groupchannel:%d:%s", userid, groupname), "add:"+trackeename)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Added %s to group %s of %s", trackeename, groupname, username)
+
+	return nil
+}
+
+func (c *client) RemoveGroupMember(username string, groupname string, trackeename string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	if err = c.checkGroup(conn, userid, groupname); err != nil {
+		return err
+	}
+	removed, err := redis.Int(conn.Do("SREM", c.key("group:%d:%s", userid, groupname), trackeename))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if removed == 0 {
+		return NewError(ErrNotFound, "User %s is not a member of group %s", trackeename, groupname)
+	}
+	_, err = conn.Do("PUBLISH", c.key("groupchannel:%d:%s", userid, groupname), "remove:"+trackeename)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Removed %s from group %s of %s", trackeename, groupname, username)
+
+	return nil
+}
+
+func (c *client) CreatePrivacyZone(username string, zone PrivacyZone) (PrivacyZone, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	if zone.Mode == "" {
+		zone.Mode = ZoneHide
+	}
+	if zone.Mode != ZoneHide && zone.Mode != ZoneSnap {
+		return PrivacyZone{}, InvalidField("zone.mode", "Unknown privacy zone mode %s", zone.Mode)
+	}
+	if zone.Latitude < -90 || zone.Latitude > 90 || zone.Longitude < -180 || zone.Longitude > 180 {
+		return PrivacyZone{}, InvalidField("zone.latitude", "Invalid privacy zone center %f:%f", zone.Latitude, zone.Longitude)
+	}
+	if zone.Radius <= 0 || zone.Radius > maxZoneRadius {
+		return PrivacyZone{}, InvalidField("zone.radius", "Privacy zone radius must be between 0 and %d meters", maxZoneRadius)
+	}
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return PrivacyZone{}, err
+	}
+	b := make([]byte, 8)
+	if _, err := rand.Read(b); err != nil {
+		return PrivacyZone{}, err
+	}
+	zone.Id = hex.EncodeToString(b)
+
+	_, err = conn.Do("HSET", c.key("zone:%s", zone.Id), "userid", userid, "name", zone.Name,
+		"latitude", zone.Latitude, "longitude", zone.Longitude, "radius", zone.Radius, "mode", zone.Mode)
+	if err != nil {
+		logger.Warn(err)
+		return PrivacyZone{}, err
+	}
+	_, err = conn.Do("SADD", c.key("zones:%d", userid), zone.Id)
+	if err != nil {
+		logger.Warn(err)
+		return PrivacyZone{}, err
+	}
+	logger.Infof("Created privacy zone %s for %s", zone.Id, username)
+
+	return zone, nil
+}
+
+func (c *client) GetPrivacyZones(username string) ([]PrivacyZone, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return nil, err
+	}
+	zoneids, err := redis.Strings(conn.Do("SMEMBERS", c.key("zones:%d", userid)))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+
+	results := []PrivacyZone{}
+	for _, zoneid := range zoneids {
+		values, err := redis.Values(conn.Do("HMGET", c.key("zone:%s", zoneid), "name", "latitude", "longitude", "radius", "mode"))
+		if err != nil {
+			logger.Warn(err)
+			return nil, err
+		}
+		zone := PrivacyZone{Id: zoneid}
+		zone.Name, _ = redis.String(values[0], nil)
+		zone.Latitude, _ = redis.Float64(values[1], nil)
+		zone.Longitude, _ = redis.Float64(values[2], nil)
+		zone.Radius, _ = redis.Float64(values[3], nil)
+		zone.Mode, _ = redis.String(values[4], nil)
+		results = append(results, zone)
+	}
+	return results, nil
+}
+
+func (c *client) DeletePrivacyZone(username string, zoneid string) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	removed, err := redis.Int(conn.Do("SREM", c.key("zones:%d", userid), zoneid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if removed == 0 {
+		return NewError(ErrNotFound, "Privacy zone %s does not exist", zoneid)
+	}
+	_, err = conn.Do("DEL", c.key("zone:%s", zoneid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Deleted privacy zone %s for %s", zoneid, username)
+
+	return nil
+}
+
+// RecordAudit appends an entry to the audit log of its target, or to the
+// log of the organization when there is no such user. Entries are never
+// changed, the log of a user is removed along with the user.
+func (c *client) RecordAudit(entry AuditEntry) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	entry.Timestamp = time.Now().Unix()
+	key := c.key("audit")
+	if entry.Target != "" {
+		if userid, err := c.getUserId(conn, entry.Target); err == nil {
+			key = c.key("audit:%d", userid)
+		}
+	}
+	value, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	_, err = conn.Do("RPUSH", key, value)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+// GetAuditLog returns up to count entries of the audit log of a user made
+// at or after since, newest first. A count of 0 returns them all.
+func (c *client) GetAuditLog(username string, since int64, count int) ([]AuditEntry, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return nil, err
+	}
+	key := c.key("audit:%d", userid)
+	length, err := redis.Int(conn.Do("LLEN", key))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+
+	results := []AuditEntry{}
+	for end := length - 1; end >= 0; end -= auditPageSize {
+		start := end - auditPageSize + 1
+		if start < 0 {
+			start = 0
+		}
+		values, err := redis.ByteSlices(conn.Do("LRANGE", key, start, end))
+		if err != nil {
+			logger.Warn(err)
+			return nil, err
+		}
+		for i := len(values) - 1; i >= 0; i-- {
+			var entry AuditEntry
+			if err := json.Unmarshal(values[i], &entry); err != nil {
+				return nil, err
+			}
+			if entry.Timestamp < since {
+				return results, nil
+			}
+			results = append(results, entry)
+			if len(results) == count {
+				return results, nil
+			}
+		}
+	}
+	return results, nil
+}
+
+// subscriber serialises the commands sent on a subscribed connection, which
+// come both from the goroutine receiving messages and from the one ending the
+// subscription when its done channel is closed.
+type subscriber struct {
+	psc  redis.PubSubConn
+	lock sync.Mutex
+}
+
+func (s *subscriber) Subscribe(channels ...interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.psc.Subscribe(channels...)
+}
+
+func (s *subscriber) Unsubscribe(channels ...interface{}) error {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	return s.psc.Unsubscribe(channels...)
+}
+
+// endOn unsubscribes from every channel once done is closed, which ends the
+// receive loop when the last unsubscription is confirmed. The returned
+// function stops waiting for done, it must be called before the connection
+// is closed.
+func (s *subscriber) endOn(done <-chan struct{}) func() {
+	stop := make(chan struct{})
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		select {
+		case <-done:
+			s.Unsubscribe()
+		case <-stop:
+		}
+	}()
+	return func() {
+		close(stop)
+		<-stopped
+	}
+}
+
+func (c *client) MonitorLocation(trackeename string, username string, done <-chan struct{}, cb MonitorFunc) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	channel := c.key("channel:%s", trackeename)
+
+	psc := &subscriber{psc: redis.PubSubConn{Conn: conn}}
+	if err := psc.Subscribe(channel); err != nil {
+		logger.Warn(err)
+		return err
+	}
+	defer psc.endOn(done)()
+	// The pause state is read once subscribed so that no change is missed
+	// in between
+	if err := c.sendPause(trackeename, cb); err != nil {
+		psc.Unsubscribe(channel)
+		return err
+	}
+	for {
+		switch v := psc.psc.Receive().(type) {
+		case redis.Message:
+			logger.Infof("%s: message: %s", v.Channel, string(v.Data))
+			if err := cb(parseUpdate(string(v.Data))); err != nil {
+				psc.Unsubscribe(channel)
+				return err
+			}
+		case redis.Subscription:
+			logger.Infof("%s: %s %d\n", v.Channel, v.Kind, v.Count)
+			if v.Count == 0 {
+				return nil
+			}
+		case error:
+			return v
+		}
+	}
+}
+
+// MonitorGroup follows the location channels of every member of a group.
+// Membership changes are published on the group channel, so members added or
+// removed while monitoring are subscribed to or dropped as they happen.
+func (c *client) MonitorGroup(username string, groupname string, done <-chan struct{}, cb GroupMonitorFunc) error {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return err
+	}
+	if err = c.checkGroup(conn, userid, groupname); err != nil {
+		return err
+	}
+
+	// Subscribe to membership changes before reading the members so that
+	// none are missed in between, the subscribed connection can no longer
+	// be used for other commands.
+	groupchannel := c.key("groupchannel:%d:%s", userid, groupname)
+	psc := &subscriber{psc: redis.PubSubConn{Conn: c.pool.Get()}}
+	defer psc.psc.Close()
+	if err = psc.Subscribe(groupchannel); err != nil {
+		return err
+	}
+	defer psc.endOn(done)()
+
+	members, err := c.getGroupMembers(conn, userid, groupname)
+	if err != nil {
+		psc.Unsubscribe()
+		return err
+	}
+	channels := make(map[string]string)
+	for _, member := range members {
+		channel := c.key("channel:%s", member)
+		channels[channel] = member
+		if err := psc.Subscribe(channel); err != nil {
+			logger.Warn(err)
+			psc.Unsubscribe()
+			return err
+		}
+		if err := c.sendGroupPause(member, cb); err != nil {
+			psc.Unsubscribe()
+			return err
+		}
+	}
+
+	for {
+		switch v := psc.psc.Receive().(type) {
+		case redis.Message:
+			if v.Channel == groupchannel {
+				parts := strings.SplitN(string(v.Data), ":", 2)
+				if len(parts) != 2 {
+					continue
+				}
+				channel := c.key("channel:%s", parts[1])
+				switch parts[0] {
+				case "add":
+					channels[channel] = parts[1]
+					if err := psc.Subscribe(channel); err != nil {
+						logger.Warn(err)
+						psc.Unsubscribe()
+						return err
+					}
+					if err := c.sendGroupPause(parts[1], cb); err != nil {
+						psc.Unsubscribe()
+						return err
+					}
+				case "remove":
+					delete(channels, channel)
+					psc.Unsubscribe(channel)
+				case "delete":
+					psc.Unsubscribe()
+					return NewError(ErrNotFound, "Group %s has been deleted", groupname)
+				}
+				continue
+			}
+			member, ok := channels[v.Channel]
+			if !ok {
+				// Published before the member was removed
+				continue
+			}
+			logger.Infof("%s: message: %s", v.Channel, string(v.Data))
+			if err := cb(member, parseUpdate(string(v.Data))); err != nil {
+				psc.Unsubscribe()
+				return err
+			}
+		case redis.Subscription:
+			logger.Infof("%s: %s %d\n", v.Channel, v.Kind, v.Count)
+			if v.Count == 0 {
+				return nil
+			}
+		case error:
+			return v
+		}
+	}
+}
+
+func (c *client) GetLocation(locationkey string) (TrackingData, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	return c.readLocation(conn, locationkey, dataKeys{})
+}
+
+func (c *client) getUserId(conn redis.Conn, username string) (int, error) {
+	id, err := conn.Do("HGET", c.key("users"), username)
+	if err != nil {
+		logger.Warn(err)
+		return -1, err
+	}
+	if id == nil {
+		return -1, NewError(ErrNotFound, "Username %s does not exist", username)
+	}
+	userid, err := redis.Int(id, nil)
+	return userid, nil
+}
+
+func (c *client) checkGroup(conn redis.Conn, userid int, groupname string) error {
+	exists, err := redis.Bool(conn.Do("HEXISTS", c.key("groups:%d", userid), groupname))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if !exists {
+		return NewError(ErrNotFound, "Group %s does not exist", groupname)
+	}
+	return nil
+}
+
+func (c *client) getGroupMembers(conn redis.Conn, userid int, groupname string) ([]string, error) {
+	members, err := redis.Strings(conn.Do("SMEMBERS", c.key("group:%d:%s", userid, groupname)))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+	return members, nil
+}
+
+func (c *client) getSessionPoints(conn redis.Conn, sessionid int64) ([]TrackingData, error) {
+	locations, err := redis.Ints(conn.Do("LRANGE", c.key("session:%d", sessionid), 0, -1))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+	results := []TrackingData{}
+	keys := dataKeys{}
+	for _, locationid := range locations {
+		td, err := c.readLocation(conn, c.key("location:%d", locationid), keys)
+		if err != nil {
+			return nil, err
+		}
+		td.Locationid = int64(locationid)
+		results = append(results, td)
+	}
+	return results, nil
+}
+
+// readLocation reads a location, decrypting its coordinates when they are
+// stored encrypted.
+func (c *client) readLocation(conn redis.Conn, locationkey string, keys dataKeys) (TrackingData, error) {
+	values, err := redis.Values(conn.Do("HMGET", locationkey, "latitude", "longitude", "timestamp", "coordinates", "owner", "keyversion"))
+	if err != nil {
+		logger.Warn(err)
+		return TrackingData{}, err
+	}
+	timestamp, _ := redis.Int64(values[2], nil)
+	if values[3] == nil {
+		latitude, _ := redis.Float64(values[0], nil)
+		longitude, _ := redis.Float64(values[1], nil)
+		return TrackingData{int64(0), longitude, latitude, timestamp}, nil
+	}
+	sealed, _ := redis.Bytes(values[3], nil)
+	owner, _ := redis.Int(values[4], nil)
+	version, _ := redis.Int(values[5], nil)
+	aead, err := c.getDataKey(conn, owner, version, keys)
+	if err != nil {
+		return TrackingData{}, err
+	}
+	plaintext, err := open(aead, sealed, []byte(locationkey))
+	if err != nil {
+		return TrackingData{}, fmt.Errorf("Location %s could not be decrypted: %v", locationkey, err)
+	}
+	latitude, longitude, err := decodeCoordinates(plaintext)
+	if err != nil {
+		return TrackingData{}, err
+	}
+	return TrackingData{int64(0), longitude, latitude, timestamp}, nil
+}
+
+func (c *client) getDataKey(conn redis.Conn, userid int, version int, keys dataKeys) (cipher.AEAD, error) {
+	if c.keyring == nil {
+		return nil, fmt.Errorf("Locations are encrypted but no encryption key is configured")
+	}
+	cachekey := fmt.Sprintf("%d:%d", userid, version)
+	if aead, ok := keys[cachekey]; ok {
+		return aead, nil
+	}
+	wrapped, err := redis.String(conn.Do("HGET", c.key("datakeys:%d", userid), version))
+	if err == redis.ErrNil {
+		return nil, fmt.Errorf("Data key %d of user %d does not exist", version, userid)
+	}
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+	aead, err := c.keyring.unwrapAEAD(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	keys[cachekey] = aead
+	return aead, nil
+}
+
+// currentDataKey returns the data key new locations of a user are encrypted
+// with, creating the first one on demand.
+func (c *client) currentDataKey(conn redis.Conn, userid int) (int, cipher.AEAD, error) {
+	version, err := redis.Int(conn.Do("HGET", c.key("user:%d", userid), "datakeyversion"))
+	if err == redis.ErrNil {
+		aead, err := c.addDataKey(conn, userid, 1)
+		return 1, aead, err
+	}
+	if err != nil {
+		logger.Warn(err)
+		return 0, nil, err
+	}
+	aead, err := c.getDataKey(conn, userid, version, dataKeys{})
+	return version, aead, err
+}
+
+// addDataKey creates a version of the data key of a user and makes it the
+// current one.
+func (c *client) addDataKey(conn redis.Conn, userid int, version int) (cipher.AEAD, error) {
+	wrapped, aead, err := c.keyring.newDataKey()
+	if err != nil {
+		return nil, err
+	}
+	created, err := redis.Bool(conn.Do("HSETNX", c.key("datakeys:%d", userid), version, wrapped))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+	if !created {
+		// another report created it first
+		aead, err = c.getDataKey(conn, userid, version, dataKeys{})
+		if err != nil {
+			return nil, err
+		}
+	}
+	_, err = conn.Do("HSET", c.key("user:%d", userid), "datakeyversion", version)
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+	return aead, nil
+}
+
+// MigrateSessions records the owner and opaque key of the sessions of the
+// users in one batch of a scan that were started before either was recorded,
+// returning how many sessions were updated and the cursor for the next batch
+// or "" when done.
+func (c *client) MigrateSessions(cursor string, count int) (int, string, error) {
+	conn := c.pool.Get()
+	defer conn.Close()
+
+	userids, next, err := c.scanUsers(conn, cursor, count)
+	if err != nil {
+		return 0, "", err
+	}
+	migrated := 0
+	for _, userid := range userids {
+		sessions, err := redis.Int64s(conn.Do("ZRANGE", c.key("sessions:%d", userid), 0, -1))
+		if err != nil {
+			logger.Warn(err)
+			return migrated, "", err
+		}
+		for _, sessionid := range sessions {
+			added, err := redis.Int(conn.Do("HSETNX", c.key("sessionowners"), sessionid, userid))
+			if err != nil {
+				logger.Warn(err)
+				return migrated, "", err
+			}
+			exists, err := redis.Bool(conn.Do("HEXISTS", c.key("sessionkeys"), sessionid))
+			if err != nil {
+				logger.Warn(err)
+				return migrated, "", err
+			}
+			if !exists {
+				if _, err := c.newSessionKey(conn, sessionid); err != nil {
+					return migrated, "", err
+				}
+			}
+			if added == 1 || !exists {
+				migrated++
+			}
+		}
+	}
+	return migrated, next, nil
+}
+
+// rotateUser moves a user to a new data key, re-encrypting their locations
+// with it and rewrapping the earlier ones with the current master key.
+func (c *client) rotateUser(conn redis.Conn, userid int) (int, error) {
+	current, err := redis.Int(conn.Do("HGET", c.key("user:%d", userid), "datakeyversion"))
+	if err != nil && err != redis.ErrNil {
+		logger.Warn(err)
+		return 0, err
+	}
+	version := current + 1
+	aead, err := c.addDataKey(conn, userid, version)
+	if err != nil {
+		return 0, err
+	}
+
+	wrappedkeys, err := redis.StringMap(conn.Do("HGETALL", c.key("datakeys:%d", userid)))
+	if err != nil {
+		logger.Warn(err)
+		return 0, err
+	}
+	for field, wrapped := range wrappedkeys {
+		if c.keyring.current(wrapped) {
+			continue
+		}
+		key, err := c.keyring.unwrap(wrapped)
+		if err != nil {
+			return 0, err
+		}
+		rewrapped, err := c.keyring.wrap(key)
+		if err != nil {
+			return 0, err
+		}
+		_, err = conn.Do("HSET", c.key("datakeys:%d", userid), field, rewrapped)
+		if err != nil {
+			logger.Warn(err)
+			return 0, err
+		}
+	}
+
+	sessions, err := redis.Int64s(conn.Do("ZRANGE", c.key("sessions:%d", userid), 0, -1))
+	if err != nil {
+		logger.Warn(err)
+		return 0, err
+	}
+	keys := dataKeys{}
+	rotated := 0
+	for _, sessionid := range sessions {
+		locations, err := redis.Ints(conn.Do("LRANGE", c.key("session:%d", sessionid), 0, -1))
+		if err != nil {
+			logger.Warn(err)
+			return rotated, err
+		}
+		for _, locationid := range locations {
+			locationkey := c.key("location:%d", locationid)
+			values, err := redis.Values(conn.Do("HMGET", locationkey, "timestamp", "keyversion"))
+			if err != nil {
+				logger.Warn(err)
+				return rotated, err
+			}
+			keyversion, _ := redis.Int(values[1], nil)
+			if values[0] == nil || keyversion == version {
+				continue
+			}
+			td, err := c.readLocation(conn, locationkey, keys)
+			if err != nil {
+				return rotated, err
+			}
+			sealed, err := seal(aead, encodeCoordinates(td.Latitude, td.Longitude), []byte(locationkey))
+			if err != nil {
+				return rotated, err
+			}
+			conn.Send("MULTI")
+			conn.Send("HSET", locationkey, "coordinates", sealed, "owner", userid, "keyversion", version)
+			conn.Send("HDEL", locationkey, "latitude", "longitude")
+			_, err = conn.Do("EXEC")
+			if err != nil {
+				logger.Warn(err)
+				return rotated, err
+			}
+			rotated++
+		}
+	}
+	return rotated, nil
+}
+
+func (c *client) getRetentionPolicy(conn redis.Conn, key string, locationsfield string, sessionsfield string) (RetentionPolicy, error) {
+	values, err := redis.Values(conn.Do("HMGET", key, locationsfield, sessionsfield))
+	if err != nil {
+		logger.Warn(err)
+		return RetentionPolicy{}, err
+	}
+	durations := make([]time.Duration, 2)
+	for i, value := range values {
+		seconds, err := redis.Int64(value, nil)
+		if err != nil {
+			continue
+		}
+		if seconds < 0 {
+			durations[i] = RetainForever
+		} else {
+			durations[i] = time.Duration(seconds) * time.Second
+		}
+	}
+	return RetentionPolicy{durations[0], durations[1]}, nil
+}
+
+// purgeUser removes the sessions of a user that started before the cutoffs
+// of policy, and the raw points of those that started before the points
+// cutoff. The current session is left alone.
+func (c *client) purgeUser(conn redis.Conn, userid int, policy RetentionPolicy) (PurgeStats, error) {
+	stats := PurgeStats{}
+	if policy.Locations == RetainForever && policy.Sessions == RetainForever {
+		return stats, nil
+	}
+	current, err := redis.Int64(conn.Do("HGET", c.key("user:%d", userid), "currentsession"))
+	if err != nil && err != redis.ErrNil {
+		logger.Warn(err)
+		return stats, err
+	}
+	sessionskey := c.key("sessions:%d", userid)
+	now := time.Now()
+
+	if policy.Sessions != RetainForever {
+		cutoff := now.Add(-policy.Sessions).Unix()
+		sessions, err := redis.Int64s(conn.Do("ZRANGEBYSCORE", sessionskey, "-inf", fmt.Sprintf("(%d", cutoff)))
+		if err != nil {
+			logger.Warn(err)
+			return stats, err
+		}
+		for _, sessionid := range sessions {
+			if sessionid == current {
+				continue
+			}
+			purged, err := c.purgeLocations(conn, sessionid)
+			stats.Locations += purged
+			if err != nil {
+				return stats, err
+			}
+			if err = c.deleteSession(conn, userid, sessionid); err != nil {
+				return stats, err
+			}
+			stats.Sessions++
+		}
+	}
+
+	if policy.Locations != RetainForever {
+		cutoff := now.Add(-policy.Locations).Unix()
+		sessions, err := redis.Int64s(conn.Do("ZRANGEBYSCORE", sessionskey, "-inf", fmt.Sprintf("(%d", cutoff)))
+		if err != nil {
+			logger.Warn(err)
+			return stats, err
+		}
+		for _, sessionid := range sessions {
+			if sessionid == current {
+				continue
+			}
+			exists, err := redis.Bool(conn.Do("EXISTS", c.key("session:%d", sessionid)))
+			if err != nil {
+				logger.Warn(err)
+				return stats, err
+			}
+			if !exists {
+				continue
+			}
+			summary, stored, err := c.getSessionSummary(conn, sessionid)
+			if err != nil {
+				return stats, err
+			}
+			if !stored {
+				points, err := c.getSessionPoints(conn, sessionid)
+				if err != nil {
+					return stats, err
+				}
+				summary = summarize(points)
+			}
+			summary.Purged = true
+			if err = c.storeSessionSummary(conn, sessionid, summary); err != nil {
+				return stats, err
+			}
+			purged, err := c.purgeLocations(conn, sessionid)
+			stats.Locations += purged
+			if err != nil {
+				return stats, err
+			}
+		}
+	}
+	return stats, nil
+}
+
+func (c *client) compactUser(conn redis.Conn, userid int, cutoff int64, options CompactionOptions) (CompactionStats, error) {
+	stats := CompactionStats{}
+	current, err := redis.Int64(conn.Do("HGET", c.key("user:%d", userid), "currentsession"))
+	if err != nil && err != redis.ErrNil {
+		logger.Warn(err)
+		return stats, err
+	}
+	sessions, err := redis.Int64s(conn.Do("ZRANGEBYSCORE", c.key("sessions:%d", userid), "-inf", fmt.Sprintf("(%d", cutoff)))
+	if err != nil {
+		logger.Warn(err)
+		return stats, err
+	}
+	for _, sessionid := range sessions {
+		if sessionid == current {
+			continue
+		}
+		// a stored summary means the session was compacted or purged already
+		_, stored, err := c.getSessionSummary(conn, sessionid)
+		if err != nil {
+			return stats, err
+		}
+		if stored {
+			continue
+		}
+		points, err := c.getSessionPoints(conn, sessionid)
+		if err != nil {
+			return stats, err
+		}
+		summary := summarize(points)
+		summary.Compacted = true
+		kept := downsample(points, options)
+
+		keptids := []int64{}
+		for _, p := range kept {
+			keptids = append(keptids, p.Locationid)
+		}
+		dropped := []string{}
+		for i, j := 0, 0; i < len(points); i++ {
+			if j < len(kept) && points[i].Locationid == kept[j].Locationid {
+				j++
+				continue
+			}
+			dropped = append(dropped, c.key("location:%d", points[i].Locationid))
+		}
+
+		sessionkey := c.key("session:%d", sessionid)
+		conn.Send("MULTI")
+		if len(dropped) > 0 {
+			conn.Send("DEL", sessionkey)
+			if len(keptids) > 0 {
+				conn.Send("RPUSH", redis.Args{}.Add(sessionkey).AddFlat(keptids)...)
+			}
+		}
+		conn.Send("HSET", c.summaryArgs(sessionid, summary)...)
+		_, err = conn.Do("EXEC")
+		if err != nil {
+			logger.Warn(err)
+			return stats, err
+		}
+		for start := 0; start < len(dropped); start += purgeChunkSize {
+			end := start + purgeChunkSize
+			if end > len(dropped) {
+				end = len(dropped)
+			}
+			_, err = conn.Do("DEL", redis.Args{}.AddFlat(dropped[start:end])...)
+			if err != nil {
+				logger.Warn(err)
+				return stats, err
+			}
+		}
+		stats.Sessions++
+		stats.Locations += len(dropped)
+	}
+	return stats, nil
+}
+
+// purgeLocations deletes the raw points of a session in chunks so that no
+// single command holds up other clients for long.
+func (c *client) purgeLocations(conn redis.Conn, sessionid int64) (int, error) {
+	sessionkey := c.key("session:%d", sessionid)
+	purged := 0
+	for {
+		locations, err := redis.Ints(conn.Do("LRANGE", sessionkey, 0, purgeChunkSize-1))
+		if err != nil {
+			logger.Warn(err)
+			return purged, err
+		}
+		if len(locations) == 0 {
+			return purged, nil
+		}
+		keys := []string{}
+		for _, locationid := range locations {
+			keys = append(keys, c.key("location:%d", locationid))
+		}
+		_, err = conn.Do("DEL", redis.Args{}.AddFlat(keys)...)
+		if err != nil {
+			logger.Warn(err)
+			return purged, err
+		}
+		_, err = conn.Do("LTRIM", sessionkey, len(locations), -1)
+		if err != nil {
+			logger.Warn(err)
+			return purged, err
+		}
+		purged += len(locations)
+	}
+}
+
+// deleteSession removes what is left of a session once its points are gone.
+func (c *client) deleteSession(conn redis.Conn, userid int, sessionid int64) error {
+	opaquekey, err := redis.String(conn.Do("HGET", c.key("sessionkeys"), sessionid))
+	if err != nil && err != redis.ErrNil {
+		logger.Warn(err)
+		return err
+	}
+	if opaquekey != "" {
+		_, err = conn.Do("HDEL", c.key("sessionids"), opaquekey)
+		if err != nil {
+			logger.Warn(err)
+			return err
+		}
+	}
+	for _, key := range []string{c.key("sessionkeys"), c.key("sessionowners")} {
+		_, err = conn.Do("HDEL", key, sessionid)
+		if err != nil {
+			logger.Warn(err)
+			return err
+		}
+	}
+	_, err = conn.Do("ZREM", c.key("sessions:%d", userid), sessionid)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("DEL", c.key("session:%d", sessionid), c.key("summary:%d", sessionid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+// summaryArgs returns the HSET arguments storing the summary of a session.
+func (c *client) summaryArgs(sessionid int64, summary SessionSummary) redis.Args {
+	return redis.Args{}.Add(c.key("summary:%d", sessionid),
+		"startedat", summary.StartedAt,
+		"endedat", summary.EndedAt,
+		"points", summary.Points,
+		"distance", summary.Distance,
+		"minlatitude", summary.MinLatitude,
+		"minlongitude", summary.MinLongitude,
+		"maxlatitude", summary.MaxLatitude,
+		"maxlongitude", summary.MaxLongitude,
+		"purged", summary.Purged,
+		"compacted", summary.Compacted)
+}
+
+func (c *client) storeSessionSummary(conn redis.Conn, sessionid int64, summary SessionSummary) error {
+	_, err := conn.Do("HSET", c.summaryArgs(sessionid, summary)...)
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	return nil
+}
+
+func (c *client) getSessionSummary(conn redis.Conn, sessionid int64) (SessionSummary, bool, error) {
+	values, err := redis.Values(conn.Do("HMGET", c.key("summary:%d", sessionid),
+		"startedat", "endedat", "points", "distance", "minlatitude", "minlongitude", "maxlatitude", "maxlongitude", "purged", "compacted"))
+	if err != nil {
+		logger.Warn(err)
+		return SessionSummary{}, false, err
+	}
+	if values[0] == nil {
+		return SessionSummary{}, false, nil
+	}
+	var summary SessionSummary
+	summary.StartedAt, _ = redis.Int64(values[0], nil)
+	summary.EndedAt, _ = redis.Int64(values[1], nil)
+	summary.Points, _ = redis.Int(values[2], nil)
+	summary.Distance, _ = redis.Float64(values[3], nil)
+	summary.MinLatitude, _ = redis.Float64(values[4], nil)
+	summary.MinLongitude, _ = redis.Float64(values[5], nil)
+	summary.MaxLatitude, _ = redis.Float64(values[6], nil)
+	summary.MaxLongitude, _ = redis.Float64(values[7], nil)
+	summary.Purged, _ = redis.Bool(values[8], nil)
+	summary.Compacted, _ = redis.Bool(values[9], nil)
+	return summary, true, nil
+}
+
+// scanUsers returns the ids of one batch of users and the cursor for the
+// next batch, or "" when done.
+func (c *client) scanUsers(conn redis.Conn, cursor string, count int) ([]int, string, error) {
+	if cursor == "" {
+		cursor = "0"
+	}
+	values, err := redis.Values(conn.Do("HSCAN", c.key("users"), cursor, "COUNT", count))
+	if err != nil {
+		logger.Warn(err)
+		return nil, "", err
+	}
+	next, err := redis.String(values[0], nil)
+	if err != nil {
+		return nil, "", err
+	}
+	entries, err := redis.Strings(values[1], nil)
+	if err != nil {
+		return nil, "", err
+	}
+	userids := []int{}
+	for i := 0; i+1 < len(entries); i += 2 {
+		userid, _ := strconv.Atoi(entries[i+1])
+		userids = append(userids, userid)
+	}
+	if next == "0" {
+		next = ""
+	}
+	return userids, next, nil
+}
+
+func (c *client) getTrackeeAndUserIds(conn redis.Conn, trackeename string, username string) (int, int, error) {
+	trackeeid, err := c.getUserId(conn, trackeename)
+	if err != nil {
+		return -1, -1, err
+	}
+	userid, err := c.getUserId(conn, username)
+	if err != nil {
+		return -1, -1, err
+	}
+	return trackeeid, userid, nil
+}
+
+func (c *client) isWatcher(conn redis.Conn, trackeeid int, userid int) (bool, error) {
+	watcherskey := c.key("watchers:%d", trackeeid)
+	score, err := conn.Do("ZSCORE", watcherskey, userid)
+	if err != nil {
+		logger.Warn(err)
+		return false, err
+	}
+	return score != nil, nil
+}
+
+func (c *client) getPause(conn redis.Conn, userid int) (Pause, error) {
+	values, err := redis.Values(conn.Do("HMGET", c.key("user:%d", userid), "pausedat", "pauseduntil", "pausedafter"))
+	if err != nil {
+		logger.Warn(err)
+		return Pause{}, err
+	}
+	var pause Pause
+	pause.Since, _ = redis.Int64(values[0], nil)
+	pause.Until, _ = redis.Int64(values[1], nil)
+	pause.LastLocationId, _ = redis.Int64(values[2], nil)
+	return pause, nil
+}
+
+func (c *client) endPause(conn redis.Conn, userid int, username string) error {
+	_, err := conn.Do("HDEL", c.key("user:%d", userid), "pausedat", "pauseduntil", "pausedafter")
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	_, err = conn.Do("PUBLISH", c.key("channel:%s", username), fmt.Sprintf("status:%s:0", StatusResumed))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	logger.Infof("Resumed sharing %s", username)
+
+	return nil
+}
+
+// sendPause lets a new subscriber know that the trackee is paused, as the
+// status was published before it subscribed.
+func (c *client) sendPause(trackeename string, cb MonitorFunc) error {
+	pause, err := c.GetPause(trackeename)
+	if err != nil {
+		return err
+	}
+	if !pause.Active(time.Now()) {
+		return nil
+	}
+	return cb(Update{Status: StatusPaused, Until: pause.Until})
+}
+
+func (c *client) sendGroupPause(member string, cb GroupMonitorFunc) error {
+	return c.sendPause(member, func(update Update) error {
+		return cb(member, update)
+	})
+}
+
+func (c *client) getPrecision(conn redis.Conn, trackeeid int, userid int) (string, error) {
+	precision, err := redis.String(conn.Do("HGET", c.key("precision:%d", trackeeid), userid))
+	if err == redis.ErrNil {
+		return PrecisionExact, nil
+	}
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	return precision, nil
+}
+
+func (c *client) getWatcherSet(conn redis.Conn, key string) ([]Watcher, error) {
+	values, err := redis.Int64s(conn.Do("ZRANGE", key, 0, -1, "WITHSCORES"))
+	if err != nil {
+		logger.Warn(err)
+		return nil, err
+	}
+	results := []Watcher{}
+	for i := 0; i+1 < len(values); i += 2 {
+		username, err := redis.String(conn.Do("HGET", c.key("user:%d", values[i]), "username"))
+		if err != nil {
+			logger.Warn(err)
+			return nil, err
+		}
+		results = append(results, Watcher{UserName: username, Timestamp: values[i+1]})
+	}
+	return results, nil
+}
+
+// getSessionKey returns the opaque key for a session.
+func (c *client) getSessionKey(conn redis.Conn, sessionid int64) (string, error) {
+	sessionkey, err := redis.String(conn.Do("HGET", c.key("sessionkeys"), sessionid))
+	if err == redis.ErrNil {
+		return "", NewError(ErrFailedPrecondition, "Session %d has no key, the data needs to be migrated", sessionid)
+	}
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	return sessionkey, nil
+}
+
+// newSessionKey assigns an opaque key to a session.
+func (c *client) newSessionKey(conn redis.Conn, sessionid int64) (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	sessionkey := hex.EncodeToString(b)
+	_, err := conn.Do("HSET", c.key("sessionids"), sessionkey, sessionid)
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	_, err = conn.Do("HSET", c.key("sessionkeys"), sessionid, sessionkey)
+	if err != nil {
+		logger.Warn(err)
+		return "", err
+	}
+	return sessionkey, nil
+}
+
+func (c *client) resolveSessionKey(conn redis.Conn, sessionkey string) (int64, error) {
+	sessionid, err := redis.Int64(conn.Do("HGET", c.key("sessionids"), sessionkey))
+	if err == redis.ErrNil {
+		return -1, NewError(ErrNotFound, "Session %s does not exist", sessionkey)
+	}
+	if err != nil {
+		logger.Warn(err)
+		return -1, err
+	}
+	return sessionid, nil
+}
+
+func (c *client) checkPassword(conn redis.Conn, userid int, password string) error {
+	userkey := c.key("user:%d", userid)
+	hash, err := redis.Bytes(conn.Do("HGET", userkey, "password"))
+	if err == redis.ErrNil {
+		return NewError(ErrUnauthenticated, "Invalid username or password")
+	}
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if bcrypt.CompareHashAndPassword(hash, []byte(password)) != nil {
+		return NewError(ErrUnauthenticated, "Invalid username or password")
+	}
+	return nil
+}
+
+func (c *client) checkApiKeyOwner(conn redis.Conn, userid int, keyid string) error {
+	owned, err := redis.Bool(conn.Do("SISMEMBER", c.key("apikeys:%d", userid), keyid))
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	if !owned {
+		return NewError(ErrNotFound, "API key %s does not exist", keyid)
+	}
+	return nil
+}
+
+// inheritRetention fills the unset durations of policy from parent.
+func inheritRetention(policy RetentionPolicy, parent RetentionPolicy) RetentionPolicy {
+	if policy.Locations == 0 {
+		policy.Locations = parent.Locations
+	}
+	if policy.Sessions == 0 {
+		policy.Sessions = parent.Sessions
+	}
+	return policy
+}
+
+func summarize(points []TrackingData) SessionSummary {
+	summary := SessionSummary{Points: len(points)}
+	for i, p := range points {
+		if i == 0 {
+			summary.StartedAt, summary.EndedAt = p.Timestamp, p.Timestamp
+			summary.MinLatitude, summary.MaxLatitude = p.Latitude, p.Latitude
+			summary.MinLongitude, summary.MaxLongitude = p.Longitude, p.Longitude
+			continue
+		}
+		summary.StartedAt = minInt64(summary.StartedAt, p.Timestamp)
+		summary.EndedAt = maxInt64(summary.EndedAt, p.Timestamp)
+		summary.MinLatitude = math.Min(summary.MinLatitude, p.Latitude)
+		summary.MaxLatitude = math.Max(summary.MaxLatitude, p.Latitude)
+		summary.MinLongitude = math.Min(summary.MinLongitude, p.Longitude)
+		summary.MaxLongitude = math.Max(summary.MaxLongitude, p.Longitude)
+		summary.Distance += distance(points[i-1], p)
+	}
+	return summary
+}
+
+// downsample picks the points of a track to keep at a reduced resolution:
+// the first and last points, points at least options.Spacing from the last
+// kept point and points where the heading turns by more than
+// options.TurnAngle.
+func downsample(points []TrackingData, options CompactionOptions) []TrackingData {
+	if len(points) <= 2 {
+		return points
+	}
+	kept := []TrackingData{points[0]}
+	for i := 1; i < len(points)-1; i++ {
+		last := kept[len(kept)-1]
+		var spacing float64
+		if options.Mode == CompactByTime {
+			spacing = float64(points[i].Timestamp - last.Timestamp)
+		} else {
+			spacing = distance(last, points[i])
+		}
+		if spacing >= options.Spacing || isTurn(last, points[i], points[i+1], options.TurnAngle) {
+			kept = append(kept, points[i])
+		}
+	}
+	return append(kept, points[len(points)-1])
+}
+
+// isTurn reports whether the heading changes by more than angle degrees at
+// b. Legs shorter than turnMinDistance are ignored as they are dominated by
+// GPS jitter.
+func isTurn(a TrackingData, b TrackingData, next TrackingData, angle float64) bool {
+	if distance(a, b) < turnMinDistance || distance(b, next) < turnMinDistance {
+		return false
+	}
+	turn := math.Abs(bearing(b, next) - bearing(a, b))
+	if turn > 180 {
+		turn = 360 - turn
+	}
+	return turn > angle
+}
+
+// bearing returns the initial heading from a to b in degrees.
+func bearing(a TrackingData, b TrackingData) float64 {
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dlon := (b.Longitude - a.Longitude) * math.Pi / 180
+	y := math.Sin(dlon) * math.Cos(lat2)
+	x := math.Cos(lat1)*math.Sin(lat2) - math.Sin(lat1)*math.Cos(lat2)*math.Cos(dlon)
+	return math.Mod(math.Atan2(y, x)*180/math.Pi+360, 360)
+}
+
+// distance returns the great circle distance between two points in meters.
+func distance(a TrackingData, b TrackingData) float64 {
+	const earthRadius = 6371000
+	lat1, lat2 := a.Latitude*math.Pi/180, b.Latitude*math.Pi/180
+	dlat := lat2 - lat1
+	dlon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dlat/2)*math.Sin(dlat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dlon/2)*math.Sin(dlon/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+func minInt64(a int64, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a int64, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// parseUpdate decodes a message published on a location channel.
+func parseUpdate(data string) Update {
+	if !strings.HasPrefix(data, "status:") {
+		return Update{LocationKey: data}
+	}
+	parts := strings.SplitN(data, ":", 3)
+	update := Update{Status: parts[1]}
+	if len(parts) == 3 {
+		update.Until, _ = strconv.ParseInt(parts[2], 10, 64)
+	}
+	return update
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// key namespaces a redis key by the client's organization.
+func (c *client) key(format string, args ...interface{}) string {
+	key := fmt.Sprintf(format, args...)
+	if c.org == "" {
+		return key
+	}
+	return "org:" + c.org + ":" + key
 }
@@ -0,0 +1,180 @@
+package db
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"strings"
+
+	"potpie.org/locationtracker/src/settings"
+)
+
+// Length in bytes of master and data keys, AES-256.
+const keySize = 32
+
+// keyring holds the master keys that wrap the per-user data keys. The
+// first key wraps new data keys, the others are only used to unwrap data
+// keys wrapped before the master key was rotated.
+type keyring struct {
+	masters []masterKey
+}
+
+type masterKey struct {
+	id   string
+	aead cipher.AEAD
+}
+
+// loadKeyring reads the master keys from settings, returning nil when
+// encryption at rest is not configured. Keys are base64 encoded, a key file
+// holds one per line with the current key first.
+func loadKeyring(s settings.Settings) (*keyring, error) {
+	encoded := []string{}
+	if s.EncryptionKeyFile != "" {
+		contents, err := ioutil.ReadFile(s.EncryptionKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		for _, line := range strings.Split(string(contents), "\n") {
+			if line = strings.TrimSpace(line); line != "" {
+				encoded = append(encoded, line)
+			}
+		}
+	} else if s.EncryptionKey != "" {
+		encoded = append(encoded, s.EncryptionKey)
+	}
+	if len(encoded) == 0 {
+		return nil, nil
+	}
+	encoded = append(encoded, s.EncryptionOldKeys...)
+
+	k := &keyring{}
+	for _, value := range encoded {
+		key, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid encryption key: %v", err)
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, err
+		}
+		sum := sha256.Sum256(key)
+		k.masters = append(k.masters, masterKey{hex.EncodeToString(sum[:4]), aead})
+	}
+	return k, nil
+}
+
+// newDataKey generates a data key and returns it wrapped by the current
+// master key.
+func (k *keyring) newDataKey() (string, cipher.AEAD, error) {
+	key := make([]byte, keySize)
+	if _, err := rand.Read(key); err != nil {
+		return "", nil, err
+	}
+	aead, err := newAEAD(key)
+	if err != nil {
+		return "", nil, err
+	}
+	wrapped, err := k.wrap(key)
+	if err != nil {
+		return "", nil, err
+	}
+	return wrapped, aead, nil
+}
+
+// wrap encrypts a data key with the current master key. The result names
+// the master key so that it can still be unwrapped after a rotation.
+func (k *keyring) wrap(key []byte) (string, error) {
+	master := k.masters[0]
+	sealed, err := seal(master.aead, key, []byte(master.id))
+	if err != nil {
+		return "", err
+	}
+	return master.id + ":" + base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func (k *keyring) unwrap(wrapped string) ([]byte, error) {
+	parts := strings.SplitN(wrapped, ":", 2)
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Malformed data key")
+	}
+	sealed, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, err
+	}
+	for _, master := range k.masters {
+		if master.id == parts[0] {
+			return open(master.aead, sealed, []byte(master.id))
+		}
+	}
+	return nil, fmt.Errorf("Data key was wrapped by unknown master key %s", parts[0])
+}
+
+// current reports whether a wrapped data key uses the current master key.
+func (k *keyring) current(wrapped string) bool {
+	return strings.HasPrefix(wrapped, k.masters[0].id+":")
+}
+
+// unwrapAEAD unwraps a data key ready for use.
+func (k *keyring) unwrapAEAD(wrapped string) (cipher.AEAD, error) {
+	key, err := k.unwrap(wrapped)
+	if err != nil {
+		return nil, err
+	}
+	return newAEAD(key)
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	if len(key) != keySize {
+		return nil, fmt.Errorf("Encryption keys must be %d bytes", keySize)
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// seal encrypts plaintext with a random nonce, which is prepended to the
+// result. aad binds the ciphertext to where it is stored.
+func seal(aead cipher.AEAD, plaintext []byte, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, sealed []byte, aad []byte) ([]byte, error) {
+	if len(sealed) < aead.NonceSize() {
+		return nil, fmt.Errorf("Malformed ciphertext")
+	}
+	nonce := sealed[:aead.NonceSize()]
+	return aead.Open(nil, nonce, sealed[aead.NonceSize():], aad)
+}
+
+func encodeCoordinates(latitude float64, longitude float64) []byte {
+	buf := make([]byte, 16)
+	binary.BigEndian.PutUint64(buf, math.Float64bits(latitude))
+	binary.BigEndian.PutUint64(buf[8:], math.Float64bits(longitude))
+	return buf
+}
+
+func decodeCoordinates(buf []byte) (float64, float64, error) {
+	if len(buf) != 16 {
+		return 0, 0, fmt.Errorf("Malformed coordinates")
+	}
+	latitude := math.Float64frombits(binary.BigEndian.Uint64(buf))
+	longitude := math.Float64frombits(binary.BigEndian.Uint64(buf[8:]))
+	return latitude, longitude, nil
+}
+
+// dataKeys caches unwrapped data keys by user and version for the duration
+// of one call.
+type dataKeys map[string]cipher.AEAD
@@ -0,0 +1,44 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Kinds of error returned by a Client and the services built on it, which
+// callers tell apart with errors.Is.
+var (
+	ErrInvalidArgument    = errors.New("invalid argument")
+	ErrNotFound           = errors.New("not found")
+	ErrAlreadyExists      = errors.New("already exists")
+	ErrUnauthenticated    = errors.New("unauthenticated")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrFailedPrecondition = errors.New("failed precondition")
+	ErrResourceExhausted  = errors.New("resource exhausted")
+)
+
+// Error is an error of a particular kind. Field names the request field at
+// fault when the kind is ErrInvalidArgument.
+type Error struct {
+	Kind    error
+	Field   string
+	Message string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Is(target error) bool {
+	return target == e.Kind
+}
+
+// NewError returns an error of kind with a formatted message.
+func NewError(kind error, format string, args ...interface{}) error {
+	return &Error{Kind: kind, Message: fmt.Sprintf(format, args...)}
+}
+
+// InvalidField returns an ErrInvalidArgument error for field.
+func InvalidField(field string, format string, args ...interface{}) error {
+	return &Error{Kind: ErrInvalidArgument, Field: field, Message: fmt.Sprintf(format, args...)}
+}
@@ -0,0 +1,149 @@
+package privacy
+
+import (
+	"math"
+	"time"
+
+	"potpie.org/locationtracker/src/db"
+)
+
+// Snapped locations are placed this many meters outside the zone so that
+// they are not inside it themselves.
+const snapMargin = 1
+
+const earthRadius = 6371000
+
+// Length of a degree of latitude in meters.
+const metersPerDegree = 111320
+
+// Grid cell size in meters for each reduced precision.
+var cellSizes = map[string]float64{
+	db.Precision100m: 100,
+	db.Precision1km:  1000,
+	db.Precision10km: 10000,
+}
+
+// Filter applies the restrictions owner has placed on their locations to
+// data shown to viewer, who is "" when anonymous. The owner sees everything.
+// Locations inside a hiding privacy zone are left out, those inside a
+// snapping zone are moved to its edge, and watchers see the rest at the
+// precision approved for them. While the owner has paused sharing, the
+// locations reported since are left out too.
+func Filter(dbclient db.Client, owner string, viewer string, data []db.TrackingData) ([]db.TrackingData, error) {
+	if viewer == owner {
+		return data, nil
+	}
+	zones, err := dbclient.GetPrivacyZones(owner)
+	if err != nil {
+		return nil, err
+	}
+	precision := db.PrecisionExact
+	if viewer != "" {
+		precision, err = dbclient.GetTrackingPrecision(owner, viewer)
+		if err != nil {
+			return nil, err
+		}
+	}
+	pause, err := dbclient.GetPause(owner)
+	if err != nil {
+		return nil, err
+	}
+	paused := pause.Active(time.Now())
+	results := []db.TrackingData{}
+	for _, td := range data {
+		if paused && td.Locationid > pause.LastLocationId {
+			continue
+		}
+		if masked, ok := Mask(zones, td); ok {
+			results = append(results, Fuzz(masked, precision))
+		}
+	}
+	return results, nil
+}
+
+// FilterLocation is Filter for a single location, ok is false when it is
+// hidden.
+func FilterLocation(dbclient db.Client, owner string, viewer string, td db.TrackingData) (db.TrackingData, bool, error) {
+	results, err := Filter(dbclient, owner, viewer, []db.TrackingData{td})
+	if err != nil {
+		return db.TrackingData{}, false, err
+	}
+	if len(results) == 0 {
+		return db.TrackingData{}, false, nil
+	}
+	return results[0], true, nil
+}
+
+// Mask applies zones to a location, ok is false when it is hidden. Hiding
+// takes precedence when the location is inside several zones.
+func Mask(zones []db.PrivacyZone, td db.TrackingData) (db.TrackingData, bool) {
+	var snap *db.PrivacyZone
+	for i, zone := range zones {
+		if distance(zone.Latitude, zone.Longitude, td.Latitude, td.Longitude) > zone.Radius {
+			continue
+		}
+		if zone.Mode != db.ZoneSnap {
+			return db.TrackingData{}, false
+		}
+		if snap == nil {
+			snap = &zones[i]
+		}
+	}
+	if snap != nil {
+		heading := bearing(snap.Latitude, snap.Longitude, td.Latitude, td.Longitude)
+		td.Latitude, td.Longitude = destination(snap.Latitude, snap.Longitude, heading, snap.Radius+snapMargin)
+	}
+	return td, true
+}
+
+// Fuzz reduces a location to precision by moving it to the center of the
+// grid cell it falls in. Every location within a cell is reported the same,
+// so positions do not jitter between updates.
+func Fuzz(td db.TrackingData, precision string) db.TrackingData {
+	size, ok := cellSizes[precision]
+	if !ok {
+		return td
+	}
+	latcell := size / metersPerDegree
+	td.Latitude = (math.Floor(td.Latitude/latcell) + 0.5) * latcell
+	// cells narrow towards the poles, size them on the snapped latitude so
+	// that the grid is the same for every location in a row
+	loncell := size / (metersPerDegree * math.Max(math.Cos(radians(td.Latitude)), 0.01))
+	td.Longitude = (math.Floor(td.Longitude/loncell) + 0.5) * loncell
+	return td
+}
+
+// distance returns the great circle distance between two points in meters.
+func distance(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dphi, dlambda := phi2-phi1, radians(lon2-lon1)
+	h := math.Sin(dphi/2)*math.Sin(dphi/2) + math.Cos(phi1)*math.Cos(phi2)*math.Sin(dlambda/2)*math.Sin(dlambda/2)
+	return 2 * earthRadius * math.Asin(math.Sqrt(h))
+}
+
+// bearing returns the initial heading from the first point to the second in
+// radians.
+func bearing(lat1 float64, lon1 float64, lat2 float64, lon2 float64) float64 {
+	phi1, phi2 := radians(lat1), radians(lat2)
+	dlambda := radians(lon2 - lon1)
+	y := math.Sin(dlambda) * math.Cos(phi2)
+	x := math.Cos(phi1)*math.Sin(phi2) - math.Sin(phi1)*math.Cos(phi2)*math.Cos(dlambda)
+	return math.Atan2(y, x)
+}
+
+// destination returns the point meters away from a start point along heading.
+func destination(lat float64, lon float64, heading float64, meters float64) (float64, float64) {
+	phi1, lambda1 := radians(lat), radians(lon)
+	delta := meters / earthRadius
+	phi2 := math.Asin(math.Sin(phi1)*math.Cos(delta) + math.Cos(phi1)*math.Sin(delta)*math.Cos(heading))
+	lambda2 := lambda1 + math.Atan2(math.Sin(heading)*math.Sin(delta)*math.Cos(phi1), math.Cos(delta)-math.Sin(phi1)*math.Sin(phi2))
+	return degrees(phi2), math.Mod(degrees(lambda2)+540, 360) - 180
+}
+
+func radians(d float64) float64 {
+	return d * math.Pi / 180
+}
+
+func degrees(r float64) float64 {
+	return r * 180 / math.Pi
+}
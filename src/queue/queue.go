@@ -0,0 +1,192 @@
+// Package queue decouples live updates from the subscribers they are sent
+// to, so that a slow subscriber does not hold up the Redis subscription
+// delivering them.
+package queue
+
+import (
+	"expvar"
+	"fmt"
+	"sync"
+
+	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/settings"
+)
+
+// Policy decides what happens to an update pushed onto a full queue.
+type Policy string
+
+const (
+	// DropOldest discards the oldest queued update.
+	DropOldest Policy = "drop-oldest"
+	// Coalesce replaces the queued updates with the same key, so that only
+	// the latest position of each trackee is kept. The oldest update is
+	// discarded when there are none.
+	Coalesce Policy = "coalesce"
+	// Disconnect ends the subscription.
+	Disconnect Policy = "disconnect"
+)
+
+var metrics = expvar.NewMap("queues")
+
+// ErrSlowConsumer ends a subscription that fell too far behind under the
+// Disconnect policy.
+var ErrSlowConsumer = db.NewError(db.ErrResourceExhausted, "Subscriber is not keeping up with updates")
+
+// Config is the size and policy of the queues a service creates.
+type Config struct {
+	Size   int
+	Policy Policy
+}
+
+// ConfigFromSettings reads the queue settings, checking the policy.
+func ConfigFromSettings(s settings.Settings) (Config, error) {
+	switch policy := Policy(s.StreamQueuePolicy); policy {
+	case DropOldest, Coalesce, Disconnect:
+		return Config{s.StreamQueueSize, policy}, nil
+	}
+	return Config{}, fmt.Errorf("Unknown queue policy %s", s.StreamQueuePolicy)
+}
+
+// New starts a queue with this configuration.
+func (c Config) New(send func(value interface{}) error) *Queue {
+	return New(c.Size, c.Policy, send)
+}
+
+type item struct {
+	key   string
+	value interface{}
+}
+
+// Queue holds up to size updates for a subscriber, which are sent in order
+// by a goroutine of its own.
+type Queue struct {
+	size   int
+	policy Policy
+	send   func(value interface{}) error
+
+	lock  sync.Mutex
+	items []item
+	err   error
+	ready chan struct{}
+	done  chan struct{}
+}
+
+// New starts sending the updates pushed onto a queue with send, until send
+// fails or the queue is closed.
+func New(size int, policy Policy, send func(value interface{}) error) *Queue {
+	if size < 1 {
+		size = 1
+	}
+	q := &Queue{
+		size:   size,
+		policy: policy,
+		send:   send,
+		ready:  make(chan struct{}, 1),
+		done:   make(chan struct{}),
+	}
+	metrics.Add("subscribers", 1)
+	go q.run()
+	return q
+}
+
+// Push queues an update without waiting for it to be sent. Updates with an
+// empty key, such as status changes, are never coalesced. The error is set
+// once the subscription has to end, because an update could not be sent or
+// the subscriber fell behind.
+func (q *Queue) Push(key string, value interface{}) error {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	if q.err != nil {
+		return q.err
+	}
+	if len(q.items) >= q.size {
+		switch q.policy {
+		case Disconnect:
+			metrics.Add("disconnected", 1)
+			q.fail(ErrSlowConsumer)
+			return q.err
+		case Coalesce:
+			if key != "" && q.coalesce(key, value) {
+				metrics.Add("coalesced", 1)
+				return nil
+			}
+			fallthrough
+		default:
+			q.items = q.items[1:]
+			metrics.Add("dropped", 1)
+		}
+	}
+	q.items = append(q.items, item{key, value})
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// coalesce replaces the queued updates with key by value, keeping the
+// position of the first.
+func (q *Queue) coalesce(key string, value interface{}) bool {
+	kept := q.items[:0]
+	found := false
+	for _, i := range q.items {
+		if i.key != key {
+			kept = append(kept, i)
+		} else if !found {
+			kept = append(kept, item{key, value})
+			found = true
+		}
+	}
+	q.items = kept
+	return found
+}
+
+// Close stops sending, discarding the updates still queued.
+func (q *Queue) Close() {
+	q.lock.Lock()
+	defer q.lock.Unlock()
+	q.fail(nil)
+}
+
+// fail ends the queue with err, q.lock must be held.
+func (q *Queue) fail(err error) {
+	select {
+	case <-q.done:
+		return
+	default:
+	}
+	q.err = err
+	if err == nil {
+		q.err = fmt.Errorf("Queue closed")
+	}
+	q.items = nil
+	close(q.done)
+	metrics.Add("subscribers", -1)
+}
+
+func (q *Queue) run() {
+	for {
+		select {
+		case <-q.done:
+			return
+		case <-q.ready:
+		}
+		for {
+			q.lock.Lock()
+			if len(q.items) == 0 || q.err != nil {
+				q.lock.Unlock()
+				break
+			}
+			next := q.items[0]
+			q.items = q.items[1:]
+			q.lock.Unlock()
+
+			if err := q.send(next.value); err != nil {
+				q.lock.Lock()
+				q.fail(err)
+				q.lock.Unlock()
+				return
+			}
+		}
+	}
+}
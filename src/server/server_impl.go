@@ -1,6 +1,7 @@
 package server
 
 import (
+	"expvar"
 	"fmt"
 	"net/http"
 	"os"
@@ -16,13 +17,17 @@ import (
 )
 
 type server struct {
-	grpcPort   int
-	grpcServer *grpc.Server
-	wsPort     int
+	grpcPort    int
+	grpcServer  *grpc.Server
+	wsPort      int
+	metricsPort int
 }
 
 func (server *server) Start(handler http.HandlerFunc) {
 	go server.startWS(handler)
+	if server.metricsPort != 0 {
+		go server.startMetrics()
+	}
 
 	server.handleGracefulShutdown()
 
@@ -41,11 +46,19 @@ func NewServer(opts ...settings.Option) Server {
 		opt(&s)
 	}
 
+	serverOpts := []grpc.ServerOption{}
+	for _, opt := range []grpc.ServerOption{s.GrpcUnaryInterceptor, s.GrpcStreamInterceptor} {
+		if opt != nil {
+			serverOpts = append(serverOpts, opt)
+		}
+	}
+
 	ret := new(server)
-	ret.grpcServer = grpc.NewServer(s.GrpcUnaryInterceptor)
+	ret.grpcServer = grpc.NewServer(serverOpts...)
 
 	ret.grpcPort = s.GrpcPort
 	ret.wsPort = s.WSPort
+	ret.metricsPort = s.MetricsPort
 
 	return ret
 }
@@ -61,6 +74,18 @@ func (server *server) startWS(handler http.HandlerFunc) {
 	http.Serve(lis, handler)
 }
 
+// startMetrics serves the expvar metrics published by the services.
+func (server *server) startMetrics() {
+	addr := fmt.Sprintf(":%d", server.metricsPort)
+	logger.Infof("Listening for metrics on '%s'", addr)
+	lis, err := reuseport.Listen("tcp", addr)
+	if err != nil {
+		logger.Fatalf("Failed to listen for metrics: %v", err)
+	}
+
+	http.Serve(lis, expvar.Handler())
+}
+
 func (server *server) handleGracefulShutdown() {
 	sigs := make(chan os.Signal, 1)
 	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM, syscall.SIGHUP)
@@ -0,0 +1,203 @@
+package ltservice
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+
+	pb "potpie.org/locationtracker/proto"
+
+	"potpie.org/locationtracker/src/auth"
+	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/privacy"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const defaultUserPageSize = 100
+
+// adminService serves LocationTrackerAdmin, access to which is limited by
+// role in the auth policy rather than in the handlers. Every action is
+// recorded in the audit log.
+type adminService struct {
+	dbclient db.Client
+}
+
+func (this *adminService) ListUsers(ctx context.Context, in *pb.ListUsersRequest) (*pb.ListUsersResponse, error) {
+	pageSize := int(in.GetPageSize())
+	if pageSize <= 0 {
+		pageSize = defaultUserPageSize
+	}
+	users, next, err := this.dbFor(ctx).ListUsers(in.GetPageToken(), pageSize)
+	recordAudit(ctx, this.dbFor(ctx), "ListUsers", "", err)
+	if err != nil {
+		return nil, err
+	}
+	results := []*pb.User{}
+
+	for _, user := range users {
+		results = append(results, &pb.User{UserId: int64(user.Id), UserName: user.UserName, Role: user.Role, Trackable: user.Trackable, Disabled: user.Disabled})
+	}
+	return &pb.ListUsersResponse{User: results, NextPageToken: next}, nil
+}
+
+func (this *adminService) DisableUser(ctx context.Context, in *pb.DisableUserRequest) (*pb.DisableUserResponse, error) {
+	if identity, _ := auth.FromContext(ctx); identity.UserName == in.GetUserName() {
+		return nil, db.NewError(db.ErrFailedPrecondition, "Administrators cannot disable themselves")
+	}
+	logger.Infof("DisableUser: %s", in.GetUserName())
+	err := this.dbFor(ctx).SetUserDisabled(in.GetUserName(), true)
+	recordAudit(ctx, this.dbFor(ctx), "DisableUser", in.GetUserName(), err)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DisableUserResponse{}, nil
+}
+
+func (this *adminService) EnableUser(ctx context.Context, in *pb.EnableUserRequest) (*pb.EnableUserResponse, error) {
+	logger.Infof("EnableUser: %s", in.GetUserName())
+	err := this.dbFor(ctx).SetUserDisabled(in.GetUserName(), false)
+	recordAudit(ctx, this.dbFor(ctx), "EnableUser", in.GetUserName(), err)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.EnableUserResponse{}, nil
+}
+
+func (this *adminService) SetUserRole(ctx context.Context, in *pb.SetUserRoleRequest) (*pb.SetUserRoleResponse, error) {
+	if identity, _ := auth.FromContext(ctx); identity.UserName == in.GetUserName() {
+		return nil, db.NewError(db.ErrFailedPrecondition, "Administrators cannot change their own role")
+	}
+	logger.Infof("SetUserRole: %s %s", in.GetUserName(), in.GetRole())
+	err := this.dbFor(ctx).SetUserRole(in.GetUserName(), in.GetRole())
+	recordAudit(ctx, this.dbFor(ctx), "SetUserRole", in.GetUserName(), err)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetUserRoleResponse{}, nil
+}
+
+func (this *adminService) GetUserSessionIds(ctx context.Context, in *pb.SessionIdsRequest) (*pb.SessionIdsResponse, error) {
+	ids, err := this.dbFor(ctx).GetSessionIds(in.GetUserName())
+	recordAudit(ctx, this.dbFor(ctx), "GetUserSessionIds", in.GetUserName(), err)
+	if err != nil {
+		return nil, err
+	}
+	results := []*pb.SessionId{}
+
+	for _, id := range ids {
+		results = append(results, &pb.SessionId{Id: id.Id, Timestamp: id.Timestamp})
+	}
+	return &pb.SessionIdsResponse{SessionId: results}, nil
+}
+
+func (this *adminService) GetUserSessionData(ctx context.Context, in *pb.SessionDataRequest) (*pb.SessionDataResponse, error) {
+	owner, err := this.dbFor(ctx).GetSessionOwner(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	data, err := this.dbFor(ctx).GetSessionData(in.GetId())
+	recordAudit(ctx, this.dbFor(ctx), "GetUserSessionData", owner, err)
+	if err != nil {
+		return nil, err
+	}
+	identity, _ := auth.FromContext(ctx)
+	data, err = privacy.Filter(this.dbFor(ctx), owner, identity.UserName, data)
+	if err != nil {
+		return nil, err
+	}
+	results := []*pb.TrackingData{}
+
+	for _, d := range data {
+		results = append(results, &pb.TrackingData{Longitude: d.Longitude, Latitude: d.Latitude, Timestamp: d.Timestamp})
+	}
+	return &pb.SessionDataResponse{TrackingData: results}, nil
+}
+
+func (this *adminService) CreateOrganization(ctx context.Context, in *pb.CreateOrganizationRequest) (*pb.CreateOrganizationResponse, error) {
+	logger.Infof("CreateOrganization: %s %s", in.GetName(), in.GetAdminUserName())
+	err := this.dbclient.CreateOrganization(in.GetName(), in.GetAdminUserName(), in.GetAdminPassword())
+	recordAudit(ctx, this.dbFor(ctx), "CreateOrganization", "", err)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateOrganizationResponse{}, nil
+}
+
+func (this *adminService) ListOrganizations(ctx context.Context, in *pb.ListOrganizationsRequest) (*pb.ListOrganizationsResponse, error) {
+	orgs, err := this.dbclient.GetOrganizations()
+	recordAudit(ctx, this.dbFor(ctx), "ListOrganizations", "", err)
+	if err != nil {
+		return nil, err
+	}
+	results := []*pb.Organization{}
+
+	for _, org := range orgs {
+		results = append(results, &pb.Organization{Name: org.Name, CreatedAt: org.CreatedAt})
+	}
+	return &pb.ListOrganizationsResponse{Organization: results}, nil
+}
+
+func (this *adminService) GetRetentionPolicy(ctx context.Context, in *pb.GetRetentionPolicyRequest) (*pb.RetentionPolicy, error) {
+	policy, err := this.dbFor(ctx).GetRetentionPolicy(in.GetUserName())
+	recordAudit(ctx, this.dbFor(ctx), "GetRetentionPolicy", in.GetUserName(), err)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RetentionPolicy{LocationsSeconds: retentionSeconds(policy.Locations), SessionsSeconds: retentionSeconds(policy.Sessions)}, nil
+}
+
+func (this *adminService) SetRetentionPolicy(ctx context.Context, in *pb.SetRetentionPolicyRequest) (*pb.SetRetentionPolicyResponse, error) {
+	logger.Infof("SetRetentionPolicy: '%s' %+v", in.GetUserName(), in.GetPolicy())
+	policy := db.RetentionPolicy{
+		Locations: retentionDuration(in.GetPolicy().GetLocationsSeconds()),
+		Sessions:  retentionDuration(in.GetPolicy().GetSessionsSeconds()),
+	}
+	err := this.dbFor(ctx).SetRetentionPolicy(in.GetUserName(), policy)
+	recordAudit(ctx, this.dbFor(ctx), "SetRetentionPolicy", in.GetUserName(), err)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetRetentionPolicyResponse{}, nil
+}
+
+func retentionSeconds(d time.Duration) int64 {
+	if d == db.RetainForever {
+		return -1
+	}
+	return int64(d / time.Second)
+}
+
+func retentionDuration(seconds int64) time.Duration {
+	if seconds < 0 {
+		return db.RetainForever
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// dbFor returns a client scoped to the organization of the caller on ctx,
+// administrators only manage their own organization.
+func (this *adminService) dbFor(ctx context.Context) db.Client {
+	identity, _ := auth.FromContext(ctx)
+	return this.dbclient.ForOrg(identity.Org)
+}
+
+func StartAdminService(grpcServer *grpc.Server) pb.LocationTrackerAdminServer {
+	newService := &adminService{db.NewClient()}
+	pb.RegisterLocationTrackerAdminServer(grpcServer, newService)
+
+	return newService
+}
+
+// GrantAdmin makes users already registered in the default organization
+// administrators of the platform, which is how the first one is appointed.
+func GrantAdmin(usernames []string) error {
+	dbclient := db.NewClient()
+	for _, username := range usernames {
+		if err := dbclient.SetUserRole(username, db.RoleAdmin); err != nil {
+			return err
+		}
+	}
+	return nil
+}
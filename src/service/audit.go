@@ -0,0 +1,16 @@
+package ltservice
+
+import (
+	"context"
+
+	"potpie.org/locationtracker/src/audit"
+	"potpie.org/locationtracker/src/auth"
+	"potpie.org/locationtracker/src/db"
+)
+
+// recordAudit records an access by the caller on ctx to the data of target,
+// which was denied when err is set.
+func recordAudit(ctx context.Context, dbclient db.Client, action string, target string, err error) {
+	identity, _ := auth.FromContext(ctx)
+	audit.Record(dbclient, identity.UserName, action, target, db.TransportGrpc, err)
+}
@@ -0,0 +1,51 @@
+package ltservice
+
+import (
+	"time"
+
+	"potpie.org/locationtracker/src/db"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// Pause between batches so that background work does not starve live
+// traffic.
+const batchPause = 100 * time.Millisecond
+
+// batchFunc processes up to count users of the organization of dbclient from
+// cursor, returning the cursor of the next batch or "" when done.
+type batchFunc func(dbclient db.Client, cursor string, count int) (string, error)
+
+// forEachOrgBatch runs fn over the users of every organization in batches of
+// batchSize. An organization that fails is logged as failing to run task and
+// left for the others to be processed, the first failure is returned.
+func forEachOrgBatch(dbclient db.Client, batchSize int, task string, fn batchFunc) error {
+	orgs, err := dbclient.GetOrganizations()
+	if err != nil {
+		logger.Warn(err)
+		return err
+	}
+	var failed error
+	// the default organization is not listed
+	orgs = append(orgs, db.Organization{})
+	for _, org := range orgs {
+		orgclient := dbclient.ForOrg(org.Name)
+		cursor := ""
+		for {
+			next, err := fn(orgclient, cursor, batchSize)
+			if err != nil {
+				logger.Warnf("%s of organization '%s' failed: %v", task, org.Name, err)
+				if failed == nil {
+					failed = err
+				}
+				break
+			}
+			if next == "" {
+				break
+			}
+			cursor = next
+			time.Sleep(batchPause)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,36 @@
+package ltservice
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"potpie.org/locationtracker/src/db"
+)
+
+func TestForEachOrgBatch(t *testing.T) {
+	dbclient := &fakeClient{orgs: []db.Organization{{Name: "acme"}, {Name: "broken"}}}
+	failure := errors.New("Batch failed")
+	batches := []string{}
+	err := forEachOrgBatch(dbclient, 10, "Test", func(dbclient db.Client, cursor string, count int) (string, error) {
+		batches = append(batches, dbclient.Org()+"@"+cursor)
+		if count != 10 {
+			t.Errorf("Batch of %d", count)
+		}
+		switch {
+		case dbclient.Org() == "broken":
+			return "", failure
+		case cursor == "":
+			return "next", nil
+		}
+		return "", nil
+	})
+	if err != failure {
+		t.Errorf("Got %v, want %v", err, failure)
+	}
+	// a failing organization does not stop the others
+	want := []string{"acme@", "acme@next", "broken@", "@", "@next"}
+	if !reflect.DeepEqual(batches, want) {
+		t.Errorf("Batches %v, want %v", batches, want)
+	}
+}
@@ -0,0 +1,70 @@
+package ltservice
+
+import (
+	"expvar"
+	"time"
+
+	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/settings"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+var compactionMetrics = expvar.NewMap("compaction")
+
+// compactor reduces the resolution of old sessions in every organization.
+type compactor struct {
+	dbclient  db.Client
+	olderThan time.Duration
+	options   db.CompactionOptions
+	interval  time.Duration
+	batchSize int
+}
+
+func (this *compactor) run() {
+	for {
+		this.compact()
+		time.Sleep(this.interval)
+	}
+}
+
+func (this *compactor) compact() {
+	start := time.Now()
+	total := db.CompactionStats{}
+	err := forEachOrgBatch(this.dbclient, this.batchSize, "Compaction", func(dbclient db.Client, cursor string, count int) (string, error) {
+		stats, next, err := dbclient.CompactSessions(this.olderThan, this.options, cursor, count)
+		total.Sessions += stats.Sessions
+		total.Locations += stats.Locations
+		compactionMetrics.Add("sessionsCompacted", int64(stats.Sessions))
+		compactionMetrics.Add("locationsDropped", int64(stats.Locations))
+		return next, err
+	})
+	if err != nil {
+		compactionMetrics.Add("errors", 1)
+	}
+	compactionMetrics.Add("runs", 1)
+	lastRun := new(expvar.Int)
+	lastRun.Set(start.Unix())
+	compactionMetrics.Set("lastRun", lastRun)
+	lastDuration := new(expvar.Float)
+	lastDuration.Set(time.Since(start).Seconds())
+	compactionMetrics.Set("lastRunSeconds", lastDuration)
+	logger.Infof("Compacted %d sessions dropping %d locations in %s", total.Sessions, total.Locations, time.Since(start))
+}
+
+// StartCompactor starts compacting old sessions in the background once
+// COMPACTION_AGE is set. Compaction permanently discards points.
+func StartCompactor() {
+	s := settings.NewSettings()
+
+	if s.CompactionAge == 0 {
+		logger.Info("Session compaction is disabled")
+		return
+	}
+	options := db.CompactionOptions{Mode: s.CompactionMode, Spacing: s.CompactionSpacing, TurnAngle: s.CompactionTurnAngle}
+	if options.Mode != db.CompactByTime && options.Mode != db.CompactByDistance {
+		logger.Fatalf("Unknown compaction mode %s", options.Mode)
+	}
+	c := &compactor{db.NewClient(), s.CompactionAge, options, s.CompactionInterval, s.BatchSize}
+	go c.run()
+}
@@ -0,0 +1,72 @@
+package ltservice
+
+import (
+	"expvar"
+	"time"
+
+	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/settings"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+var janitorMetrics = expvar.NewMap("janitor")
+
+// janitor enforces the retention policies of every organization.
+type janitor struct {
+	dbclient  db.Client
+	defaults  db.RetentionPolicy
+	interval  time.Duration
+	batchSize int
+}
+
+func (this *janitor) run() {
+	for {
+		this.purge()
+		time.Sleep(this.interval)
+	}
+}
+
+func (this *janitor) purge() {
+	start := time.Now()
+	total := db.PurgeStats{}
+	err := forEachOrgBatch(this.dbclient, this.batchSize, "Purge", func(dbclient db.Client, cursor string, count int) (string, error) {
+		stats, next, err := dbclient.PurgeExpired(this.defaults, cursor, count)
+		total.Sessions += stats.Sessions
+		total.Locations += stats.Locations
+		janitorMetrics.Add("sessionsPurged", int64(stats.Sessions))
+		janitorMetrics.Add("locationsPurged", int64(stats.Locations))
+		return next, err
+	})
+	if err != nil {
+		janitorMetrics.Add("errors", 1)
+	}
+	janitorMetrics.Add("runs", 1)
+	lastRun := new(expvar.Int)
+	lastRun.Set(start.Unix())
+	janitorMetrics.Set("lastRun", lastRun)
+	lastDuration := new(expvar.Float)
+	lastDuration.Set(time.Since(start).Seconds())
+	janitorMetrics.Set("lastRunSeconds", lastDuration)
+	logger.Infof("Janitor purged %d sessions and %d locations in %s", total.Sessions, total.Locations, time.Since(start))
+}
+
+// StartJanitor starts purging data past its retention in the background,
+// unless no retention is set for the deployment.
+func StartJanitor() {
+	s := settings.NewSettings()
+
+	if s.RetentionLocations == 0 && s.RetentionSessions == 0 {
+		logger.Info("Retention is not set, data is kept forever")
+		return
+	}
+	defaults := db.RetentionPolicy{Locations: s.RetentionLocations, Sessions: s.RetentionSessions}
+	if defaults.Locations == 0 {
+		defaults.Locations = db.RetainForever
+	}
+	if defaults.Sessions == 0 {
+		defaults.Sessions = db.RetainForever
+	}
+	j := &janitor{db.NewClient(), defaults, s.JanitorInterval, s.BatchSize}
+	go j.run()
+}
@@ -0,0 +1,32 @@
+package ltservice
+
+import (
+	"time"
+
+	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/settings"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// RotateKeys re-encrypts the stored locations of every organization under
+// new data keys wrapped by the current master key. Once it has completed,
+// old master keys can be removed from the configuration.
+func RotateKeys() error {
+	s := settings.NewSettings()
+	dbclient := db.NewClient()
+
+	start := time.Now()
+	total := db.RotationStats{}
+	err := forEachOrgBatch(dbclient, s.BatchSize, "Key rotation", func(dbclient db.Client, cursor string, count int) (string, error) {
+		stats, next, err := dbclient.RotateKeys(cursor, count)
+		total.Users += stats.Users
+		total.Locations += stats.Locations
+		return next, err
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("Rotated keys of %d users and %d locations in %s", total.Users, total.Locations, time.Since(start))
+	return nil
+}
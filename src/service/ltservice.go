@@ -2,48 +2,169 @@ package ltservice
 
 import (
 	"context"
+	"encoding/json"
 	"io"
+	"sync"
+	"time"
 
 	"google.golang.org/grpc"
 
 	pb "potpie.org/locationtracker/proto"
 
+	"potpie.org/locationtracker/src/audit"
+	"potpie.org/locationtracker/src/auth"
 	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/privacy"
+	"potpie.org/locationtracker/src/queue"
+	"potpie.org/locationtracker/src/settings"
 
 	logger "github.com/sirupsen/logrus"
 )
 
 type service struct {
-	dbclient db.Client
+	dbclient      db.Client
+	authenticator auth.Authenticator
+	queues        queue.Config
+	// lock guards sessions, which holds the stream of each watcher tracking
+	// each trackee
+	lock     sync.Mutex
 	sessions map[string]pb.LocationTracker_StartTrackingServer
 }
 
 func (this *service) Register(ctx context.Context, in *pb.RegisterRequest) (*pb.RegisterResponse, error) {
-	userid, err := this.dbclient.Register(in.GetUserName(), in.Trackable)
+	var identity *auth.Identity
+	if id, ok := auth.FromContext(ctx); ok {
+		identity = &id
+	}
+	if err := auth.CheckRegistration(identity, in.GetOrganization()); err != nil {
+		return nil, err
+	}
+	exists, err := this.dbclient.OrganizationExists(in.GetOrganization())
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, db.NewError(db.ErrNotFound, "Organization %s does not exist", in.GetOrganization())
+	}
+	userid, err := this.dbclient.ForOrg(in.GetOrganization()).Register(in.GetUserName(), in.GetPassword(), in.Trackable)
 	if err != nil {
 		return nil, err
 	}
 	return &pb.RegisterResponse{UserId: int64(userid)}, nil
 }
 
+func (this *service) Login(ctx context.Context, in *pb.LoginRequest) (*pb.LoginResponse, error) {
+	tokens, err := this.authenticator.Login(in.GetOrganization(), in.GetUserName(), in.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LoginResponse{AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken, ExpiresAt: tokens.ExpiresAt}, nil
+}
+
+func (this *service) RefreshToken(ctx context.Context, in *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	tokens, err := this.authenticator.Refresh(in.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RefreshTokenResponse{AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken, ExpiresAt: tokens.ExpiresAt}, nil
+}
+
+func (this *service) RevokeToken(ctx context.Context, in *pb.RevokeTokenRequest) (*pb.RevokeTokenResponse, error) {
+	var identity *auth.Identity
+	if id, ok := auth.FromContext(ctx); ok {
+		identity = &id
+	}
+	err := this.authenticator.Revoke(identity, in.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeTokenResponse{}, nil
+}
+
+func (this *service) ChangePassword(ctx context.Context, in *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).ChangePassword(in.GetUserName(), in.GetOldPassword(), in.GetNewPassword())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ChangePasswordResponse{}, nil
+}
+
 func (this *service) GetTrackables(ctx context.Context, in *pb.GetTrackablesRequest) (*pb.GetTrackablesResponse, error) {
-	trackables, err := this.dbclient.GetTrackables()
+	trackables, err := this.dbFor(ctx).GetTrackables()
 	if err != nil {
 		return nil, err
 	}
 	return &pb.GetTrackablesResponse{UserName: trackables}, nil
 }
 
+func (this *service) CreateApiKey(ctx context.Context, in *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	apikey, key, err := this.authenticator.CreateApiKey(this.dbFor(ctx).Org(), in.GetUserName(), in.GetLabel())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateApiKeyResponse{ApiKey: &pb.ApiKey{KeyId: apikey.Id, Label: apikey.Label, CreatedAt: apikey.CreatedAt, LastUsedAt: apikey.LastUsedAt}, Key: key}, nil
+}
+
+func (this *service) ListApiKeys(ctx context.Context, in *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	apikeys, err := this.dbFor(ctx).GetApiKeys(in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	results := []*pb.ApiKey{}
+
+	for _, apikey := range apikeys {
+		results = append(results, &pb.ApiKey{KeyId: apikey.Id, Label: apikey.Label, CreatedAt: apikey.CreatedAt, LastUsedAt: apikey.LastUsedAt})
+	}
+	return &pb.ListApiKeysResponse{ApiKey: results}, nil
+}
+
+func (this *service) LabelApiKey(ctx context.Context, in *pb.LabelApiKeyRequest) (*pb.LabelApiKeyResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).LabelApiKey(in.GetUserName(), in.GetKeyId(), in.GetLabel())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.LabelApiKeyResponse{}, nil
+}
+
+func (this *service) RevokeApiKey(ctx context.Context, in *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).RevokeApiKey(in.GetUserName(), in.GetKeyId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeApiKeyResponse{}, nil
+}
+
 func (this *service) StartSession(ctx context.Context, in *pb.StartSessionRequest) (*pb.StartSessionResponse, error) {
-	_, err := this.dbclient.StartSession(in.GetUserName())
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	sessionid, err := this.dbFor(ctx).StartSession(in.GetUserName())
 	if err != nil {
 		return nil, err
 	}
-	return &pb.StartSessionResponse{}, nil
+	return &pb.StartSessionResponse{SessionId: sessionid}, nil
 }
 
 func (this *service) StopSession(ctx context.Context, in *pb.StopSessionRequest) (*pb.StopSessionResponse, error) {
-	err := this.dbclient.StopSession(in.GetUserName())
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).StopSession(in.GetUserName())
 	if err != nil {
 		return nil, err
 	}
@@ -51,31 +172,60 @@ func (this *service) StopSession(ctx context.Context, in *pb.StopSessionRequest)
 }
 
 func (this *service) StartTracking(in *pb.StartTrackingRequest, stream pb.LocationTracker_StartTrackingServer) error {
-	_, ok := this.sessions[in.GetTrackeeName()+":"+in.GetUserName()]
-	if ok {
-		delete(this.sessions, in.GetTrackeeName()+":"+in.GetUserName())
+	dbclient := this.dbFor(stream.Context())
+	err := auth.CheckUser(stream.Context(), in.GetUserName())
+	if err == nil {
+		err = dbclient.StartTracking(in.GetTrackeeName(), in.GetUserName())
 	}
-	this.sessions[in.GetTrackeeName()+":"+in.GetUserName()] = stream
-	err := this.dbclient.StartTracking(in.GetTrackeeName(), in.GetUserName())
+	recordAudit(stream.Context(), dbclient, "StartTracking", in.GetTrackeeName(), err)
 	if err != nil {
 		return err
 	}
+	key := trackingKey(dbclient.Org(), in.GetTrackeeName(), in.GetUserName())
+	this.lock.Lock()
+	this.sessions[key] = stream
+	this.lock.Unlock()
+	defer func() {
+		this.lock.Lock()
+		defer this.lock.Unlock()
+		// a later stream for the same key replaces this one
+		if this.sessions[key] == stream {
+			delete(this.sessions, key)
+		}
+	}()
 
-	cb := func(locationkey string) error {
-		td, err := this.dbclient.GetLocation(locationkey)
+	// updates are sent from a queue so that a slow client does not hold up
+	// the subscription
+	q := this.queues.New(func(td interface{}) error {
+		return stream.Send(td.(*pb.TrackingData))
+	})
+	defer q.Close()
+	cb := func(update db.Update) error {
+		approved, err := dbclient.IsWatcher(in.GetTrackeeName(), in.GetUserName())
 		if err != nil {
 			return err
 		}
-		logger.Infof("Location: %+v", td)
-
-		if err := stream.Send(&pb.TrackingData{TrackeeName: in.GetTrackeeName(), Longitude: td.Longitude, Latitude: td.Latitude, Timestamp: td.Timestamp}); err != nil {
+		if !approved {
+			return db.NewError(db.ErrPermissionDenied, "User %s is no longer approved to track %s", in.GetUserName(), in.GetTrackeeName())
+		}
+		if update.Status != "" {
+			return q.Push("", &pb.TrackingData{TrackeeName: in.GetTrackeeName(), Status: update.Status, PausedUntil: update.Until})
+		}
+		td, err := dbclient.GetLocation(update.LocationKey)
+		if err != nil {
+			return err
+		}
+		td, visible, err := privacy.FilterLocation(dbclient, in.GetTrackeeName(), in.GetUserName(), td)
+		if err != nil || !visible {
 			return err
 		}
-		return nil
+		logger.Infof("Location: %+v", td)
+
+		return q.Push(in.GetTrackeeName(), &pb.TrackingData{TrackeeName: in.GetTrackeeName(), Longitude: td.Longitude, Latitude: td.Latitude, Timestamp: td.Timestamp})
 	}
 
 	logger.Infof("StartTracking 1: %s %s", in.GetTrackeeName(), in.GetUserName())
-	err = this.dbclient.MonitorLocation(in.GetTrackeeName(), in.GetUserName(), cb)
+	err = dbclient.MonitorLocation(in.GetTrackeeName(), in.GetUserName(), stream.Context().Done(), cb)
 	logger.Infof("StartTracking 2: %s %s", in.GetTrackeeName(), in.GetUserName())
 	if err != nil {
 		return err
@@ -85,12 +235,18 @@ func (this *service) StartTracking(in *pb.StartTrackingRequest, stream pb.Locati
 
 func (this *service) StopTracking(ctx context.Context, in *pb.StopTrackingRequest) (*pb.StopTrackingResponse, error) {
 	logger.Infof("StopTracking: %s %s", in.GetTrackeeName(), in.GetUserName())
-	_, ok := this.sessions[in.GetTrackeeName()+":"+in.GetUserName()]
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	key := trackingKey(this.dbFor(ctx).Org(), in.GetTrackeeName(), in.GetUserName())
+	this.lock.Lock()
+	_, ok := this.sessions[key]
+	delete(this.sessions, key)
+	this.lock.Unlock()
 	if !ok {
-		return nil, nil
+		return nil, db.NewError(db.ErrNotFound, "User %s is not tracking %s", in.GetUserName(), in.GetTrackeeName())
 	}
-	delete(this.sessions, in.GetTrackeeName()+":"+in.GetUserName())
-	err := this.dbclient.StopTracking(in.GetTrackeeName(), in.GetUserName())
+	err := this.dbFor(ctx).StopTracking(in.GetTrackeeName(), in.GetUserName())
 	if err != nil {
 		return nil, err
 	}
@@ -105,28 +261,75 @@ func (this *service) ReportLocation(stream pb.LocationTracker_ReportLocationServ
 		if err == io.EOF {
 			return stream.SendAndClose(&pb.ReportLocationResponse{})
 		}
-		err = this.dbclient.ReportLocation(in.GetTrackeeName(), in.GetLongitude(), in.GetLatitude(), in.GetTimestamp())
 		if err != nil {
 			return err
 		}
+		if err := auth.CheckUser(stream.Context(), in.GetTrackeeName()); err != nil {
+			return err
+		}
+		err = this.dbFor(stream.Context()).ReportLocation(in.GetTrackeeName(), in.GetLongitude(), in.GetLatitude(), in.GetTimestamp())
+		if err != nil {
+			return err
+		}
+	}
+}
+
+func (this *service) PauseSharing(ctx context.Context, in *pb.PauseSharingRequest) (*pb.PauseSharingResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).PauseSharing(in.GetUserName(), in.GetUntil())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PauseSharingResponse{}, nil
+}
+
+func (this *service) ResumeSharing(ctx context.Context, in *pb.ResumeSharingRequest) (*pb.ResumeSharingResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).ResumeSharing(in.GetUserName())
+	if err != nil {
+		return nil, err
 	}
+	return &pb.ResumeSharingResponse{}, nil
 }
 
 func (this *service) GetSessionIds(ctx context.Context, in *pb.SessionIdsRequest) (*pb.SessionIdsResponse, error) {
-	ids, err := this.dbclient.GetSessionIds(in.GetUserName())
+	err := this.checkWatcher(ctx, in.GetUserName())
+	recordAudit(ctx, this.dbFor(ctx), "GetSessionIds", in.GetUserName(), err)
+	if err != nil {
+		return nil, err
+	}
+	ids, err := this.dbFor(ctx).GetSessionIds(in.GetUserName())
 	if err != nil {
 		return nil, err
 	}
 	results := []*pb.SessionId{}
 
 	for _, id := range ids {
-		results = append(results, &pb.SessionId{SessionId: id.Id, Timestamp: id.Timestamp})
+		results = append(results, &pb.SessionId{Id: id.Id, Timestamp: id.Timestamp})
 	}
 	return &pb.SessionIdsResponse{SessionId: results}, nil
 }
 
 func (this *service) GetSessionData(ctx context.Context, in *pb.SessionDataRequest) (*pb.SessionDataResponse, error) {
-	data, err := this.dbclient.GetSessionData(in.SessionId)
+	owner, err := this.dbFor(ctx).GetSessionOwner(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = this.checkWatcher(ctx, owner)
+	recordAudit(ctx, this.dbFor(ctx), "GetSessionData", owner, err)
+	if err != nil {
+		return nil, err
+	}
+	data, err := this.dbFor(ctx).GetSessionData(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	identity, _ := auth.FromContext(ctx)
+	data, err = privacy.Filter(this.dbFor(ctx), owner, identity.UserName, data)
 	if err != nil {
 		return nil, err
 	}
@@ -138,8 +341,368 @@ func (this *service) GetSessionData(ctx context.Context, in *pb.SessionDataReque
 	return &pb.SessionDataResponse{TrackingData: results}, nil
 }
 
-func StartService(grpcServer *grpc.Server) pb.LocationTrackerServer {
-	newService := &service{db.NewClient(), make(map[string]pb.LocationTracker_StartTrackingServer)}
+func (this *service) GetSessionSummary(ctx context.Context, in *pb.SessionDataRequest) (*pb.SessionSummary, error) {
+	owner, err := this.dbFor(ctx).GetSessionOwner(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	err = this.checkWatcher(ctx, owner)
+	recordAudit(ctx, this.dbFor(ctx), "GetSessionSummary", owner, err)
+	if err != nil {
+		return nil, err
+	}
+	summary, err := this.dbFor(ctx).GetSessionSummary(in.GetId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SessionSummary{
+		Id:           summary.Id,
+		StartedAt:    summary.StartedAt,
+		EndedAt:      summary.EndedAt,
+		Points:       int64(summary.Points),
+		Distance:     summary.Distance,
+		MinLatitude:  summary.MinLatitude,
+		MinLongitude: summary.MinLongitude,
+		MaxLatitude:  summary.MaxLatitude,
+		MaxLongitude: summary.MaxLongitude,
+		Purged:       summary.Purged,
+		Compacted:    summary.Compacted,
+	}, nil
+}
+
+func (this *service) RequestTracking(ctx context.Context, in *pb.RequestTrackingRequest) (*pb.RequestTrackingResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).RequestTracking(in.GetTrackeeName(), in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RequestTrackingResponse{}, nil
+}
+
+func (this *service) ApproveTracking(ctx context.Context, in *pb.ApproveTrackingRequest) (*pb.ApproveTrackingResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetTrackeeName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).ApproveTracking(in.GetTrackeeName(), in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApproveTrackingResponse{}, nil
+}
+
+func (this *service) DenyTracking(ctx context.Context, in *pb.DenyTrackingRequest) (*pb.DenyTrackingResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetTrackeeName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).DenyTracking(in.GetTrackeeName(), in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DenyTrackingResponse{}, nil
+}
+
+func (this *service) RevokeTracking(ctx context.Context, in *pb.RevokeTrackingRequest) (*pb.RevokeTrackingResponse, error) {
+	// Either the trackee or the watcher may end the relationship
+	if err := auth.CheckUser(ctx, in.GetTrackeeName()); err != nil {
+		if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+			return nil, err
+		}
+	}
+	err := this.dbFor(ctx).RevokeTracking(in.GetTrackeeName(), in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeTrackingResponse{}, nil
+}
+
+func (this *service) GetWatchers(ctx context.Context, in *pb.GetWatchersRequest) (*pb.GetWatchersResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	pending, approved, err := this.dbFor(ctx).GetWatchers(in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.GetWatchersResponse{Pending: []*pb.Watcher{}, Approved: []*pb.Watcher{}}
+
+	for _, w := range pending {
+		response.Pending = append(response.Pending, &pb.Watcher{UserName: w.UserName, Timestamp: w.Timestamp})
+	}
+	for _, w := range approved {
+		response.Approved = append(response.Approved, &pb.Watcher{UserName: w.UserName, Timestamp: w.Timestamp, Precision: w.Precision})
+	}
+	return response, nil
+}
+
+func (this *service) SetTrackingPrecision(ctx context.Context, in *pb.SetTrackingPrecisionRequest) (*pb.SetTrackingPrecisionResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetTrackeeName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).SetTrackingPrecision(in.GetTrackeeName(), in.GetUserName(), in.GetPrecision())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetTrackingPrecisionResponse{}, nil
+}
+
+func (this *service) CreateShareLink(ctx context.Context, in *pb.CreateShareLinkRequest) (*pb.CreateShareLinkResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	sharelink, token, err := this.authenticator.CreateShareLink(this.dbFor(ctx).Org(), in.GetUserName(), time.Duration(in.GetDurationSeconds())*time.Second, in.GetSessionId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateShareLinkResponse{ShareLink: &pb.ShareLink{ShareId: sharelink.Id, SessionId: sharelink.SessionId, CreatedAt: sharelink.CreatedAt, ExpiresAt: sharelink.ExpiresAt}, Token: token}, nil
+}
+
+func (this *service) ListShareLinks(ctx context.Context, in *pb.ListShareLinksRequest) (*pb.ListShareLinksResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	sharelinks, err := this.dbFor(ctx).GetShareLinks(in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	results := []*pb.ShareLink{}
+
+	for _, sharelink := range sharelinks {
+		results = append(results, &pb.ShareLink{ShareId: sharelink.Id, SessionId: sharelink.SessionId, CreatedAt: sharelink.CreatedAt, ExpiresAt: sharelink.ExpiresAt})
+	}
+	return &pb.ListShareLinksResponse{ShareLink: results}, nil
+}
+
+func (this *service) RevokeShareLink(ctx context.Context, in *pb.RevokeShareLinkRequest) (*pb.RevokeShareLinkResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).RevokeShareLink(in.GetUserName(), in.GetShareId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RevokeShareLinkResponse{}, nil
+}
+
+func (this *service) CreateGroup(ctx context.Context, in *pb.CreateGroupRequest) (*pb.CreateGroupResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	group, err := this.dbFor(ctx).CreateGroup(in.GetUserName(), in.GetGroupName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateGroupResponse{Group: &pb.Group{GroupName: group.Name, Member: group.Members, CreatedAt: group.CreatedAt}}, nil
+}
+
+func (this *service) DeleteGroup(ctx context.Context, in *pb.DeleteGroupRequest) (*pb.DeleteGroupResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).DeleteGroup(in.GetUserName(), in.GetGroupName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteGroupResponse{}, nil
+}
+
+func (this *service) ListGroups(ctx context.Context, in *pb.ListGroupsRequest) (*pb.ListGroupsResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	groups, err := this.dbFor(ctx).GetGroups(in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ListGroupsResponse{Group: []*pb.Group{}}
+
+	for _, g := range groups {
+		response.Group = append(response.Group, &pb.Group{GroupName: g.Name, Member: g.Members, CreatedAt: g.CreatedAt})
+	}
+	return response, nil
+}
+
+func (this *service) AddGroupMember(ctx context.Context, in *pb.AddGroupMemberRequest) (*pb.AddGroupMemberResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).AddGroupMember(in.GetUserName(), in.GetGroupName(), in.GetTrackeeName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.AddGroupMemberResponse{}, nil
+}
+
+func (this *service) RemoveGroupMember(ctx context.Context, in *pb.RemoveGroupMemberRequest) (*pb.RemoveGroupMemberResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).RemoveGroupMember(in.GetUserName(), in.GetGroupName(), in.GetTrackeeName())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RemoveGroupMemberResponse{}, nil
+}
+
+func (this *service) StartGroupTracking(in *pb.StartGroupTrackingRequest, stream pb.LocationTracker_StartGroupTrackingServer) error {
+	if err := auth.CheckUser(stream.Context(), in.GetUserName()); err != nil {
+		return err
+	}
+	dbclient := this.dbFor(stream.Context())
+
+	// updates are sent from a queue so that a slow client does not hold up
+	// the subscription
+	q := this.queues.New(func(td interface{}) error {
+		return stream.Send(td.(*pb.TrackingData))
+	})
+	defer q.Close()
+	// members are audited when the first of their updates is delivered
+	audited := make(map[string]bool)
+	cb := func(trackeename string, update db.Update) error {
+		approved, err := dbclient.IsWatcher(trackeename, in.GetUserName())
+		if err != nil {
+			return err
+		}
+		if !approved {
+			// Consent was withdrawn for this member only, keep following the others
+			return nil
+		}
+		if !audited[trackeename] {
+			recordAudit(stream.Context(), dbclient, "StartGroupTracking", trackeename, nil)
+			audited[trackeename] = true
+		}
+		if update.Status != "" {
+			return q.Push("", &pb.TrackingData{TrackeeName: trackeename, Status: update.Status, PausedUntil: update.Until})
+		}
+		td, err := dbclient.GetLocation(update.LocationKey)
+		if err != nil {
+			return err
+		}
+		td, visible, err := privacy.FilterLocation(dbclient, trackeename, in.GetUserName(), td)
+		if err != nil || !visible {
+			return err
+		}
+
+		return q.Push(trackeename, &pb.TrackingData{TrackeeName: trackeename, Longitude: td.Longitude, Latitude: td.Latitude, Timestamp: td.Timestamp})
+	}
+
+	logger.Infof("StartGroupTracking: %s %s", in.GetGroupName(), in.GetUserName())
+	return dbclient.MonitorGroup(in.GetUserName(), in.GetGroupName(), stream.Context().Done(), cb)
+}
+
+func (this *service) DeleteAccount(ctx context.Context, in *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).DeleteUser(in.GetUserName(), in.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeleteAccountResponse{}, nil
+}
+
+func (this *service) ExportMyData(ctx context.Context, in *pb.ExportMyDataRequest) (*pb.ExportMyDataResponse, error) {
+	err := auth.CheckUser(ctx, in.GetUserName())
+	recordAudit(ctx, this.dbFor(ctx), "ExportMyData", in.GetUserName(), err)
+	if err != nil {
+		return nil, err
+	}
+	export, err := this.dbFor(ctx).ExportUser(in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	data, err := json.MarshalIndent(export, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportMyDataResponse{Data: data, ContentType: "application/json"}, nil
+}
+
+func (this *service) CreatePrivacyZone(ctx context.Context, in *pb.CreatePrivacyZoneRequest) (*pb.CreatePrivacyZoneResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	z := in.GetZone()
+	zone, err := this.dbFor(ctx).CreatePrivacyZone(in.GetUserName(), db.PrivacyZone{Name: z.GetName(), Latitude: z.GetLatitude(), Longitude: z.GetLongitude(), Radius: z.GetRadius(), Mode: z.GetMode()})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreatePrivacyZoneResponse{Zone: &pb.PrivacyZone{ZoneId: zone.Id, Name: zone.Name, Latitude: zone.Latitude, Longitude: zone.Longitude, Radius: zone.Radius, Mode: zone.Mode}}, nil
+}
+
+func (this *service) ListPrivacyZones(ctx context.Context, in *pb.ListPrivacyZonesRequest) (*pb.ListPrivacyZonesResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	zones, err := this.dbFor(ctx).GetPrivacyZones(in.GetUserName())
+	if err != nil {
+		return nil, err
+	}
+	response := &pb.ListPrivacyZonesResponse{Zone: []*pb.PrivacyZone{}}
+
+	for _, z := range zones {
+		response.Zone = append(response.Zone, &pb.PrivacyZone{ZoneId: z.Id, Name: z.Name, Latitude: z.Latitude, Longitude: z.Longitude, Radius: z.Radius, Mode: z.Mode})
+	}
+	return response, nil
+}
+
+func (this *service) DeletePrivacyZone(ctx context.Context, in *pb.DeletePrivacyZoneRequest) (*pb.DeletePrivacyZoneResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	err := this.dbFor(ctx).DeletePrivacyZone(in.GetUserName(), in.GetZoneId())
+	if err != nil {
+		return nil, err
+	}
+	return &pb.DeletePrivacyZoneResponse{}, nil
+}
+
+func (this *service) GetAuditLog(ctx context.Context, in *pb.GetAuditLogRequest) (*pb.GetAuditLogResponse, error) {
+	if err := auth.CheckUser(ctx, in.GetUserName()); err != nil {
+		return nil, err
+	}
+	entries, err := this.dbFor(ctx).GetAuditLog(in.GetUserName(), in.GetSince(), audit.Limit(int(in.GetLimit())))
+	if err != nil {
+		return nil, err
+	}
+	results := []*pb.AuditEntry{}
+
+	for _, e := range entries {
+		results = append(results, &pb.AuditEntry{Timestamp: e.Timestamp, Actor: e.Actor, Target: e.Target, Action: e.Action, Transport: e.Transport, Outcome: e.Outcome, Reason: e.Reason})
+	}
+	return &pb.GetAuditLogResponse{Entry: results}, nil
+}
+
+func (this *service) checkWatcher(ctx context.Context, trackeename string) error {
+	identity, ok := auth.FromContext(ctx)
+	if !ok {
+		return db.NewError(db.ErrUnauthenticated, "Not authenticated")
+	}
+	return identity.CheckWatcher(this.dbFor(ctx), trackeename)
+}
+
+// dbFor returns a client scoped to the organization of the caller on ctx.
+func (this *service) dbFor(ctx context.Context) db.Client {
+	identity, _ := auth.FromContext(ctx)
+	return this.dbclient.ForOrg(identity.Org)
+}
+
+func trackingKey(org string, trackeename string, username string) string {
+	return org + ":" + trackeename + ":" + username
+}
+
+func StartService(grpcServer *grpc.Server, authenticator auth.Authenticator) pb.LocationTrackerServer {
+	queues, err := queue.ConfigFromSettings(settings.NewSettings())
+	if err != nil {
+		logger.Fatal(err)
+	}
+	newService := &service{
+		dbclient:      db.NewClient(),
+		authenticator: authenticator,
+		queues:        queues,
+		sessions:      make(map[string]pb.LocationTracker_StartTrackingServer),
+	}
 	pb.RegisterLocationTrackerServer(grpcServer, newService)
 
 	return newService
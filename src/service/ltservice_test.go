@@ -0,0 +1,149 @@
+package ltservice
+
+import (
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	pb "potpie.org/locationtracker/proto"
+
+	"potpie.org/locationtracker/src/auth"
+	"potpie.org/locationtracker/src/db"
+)
+
+// fakeClient stores just what the handlers under test need, any other call
+// panics on the nil Client it embeds.
+type fakeClient struct {
+	db.Client
+	// users with a session in progress
+	sessions map[string]bool
+	// returned by every call when set, as when Redis is down
+	err  error
+	orgs []db.Organization
+	org  string
+}
+
+func (c *fakeClient) ForOrg(org string) db.Client {
+	scoped := *c
+	scoped.org = org
+	return &scoped
+}
+
+func (c *fakeClient) Org() string { return c.org }
+
+func (c *fakeClient) GetOrganizations() ([]db.Organization, error) {
+	return c.orgs, c.err
+}
+
+func (c *fakeClient) ReportLocation(username string, longitude float64, latitude float64, timestamp int64) error {
+	if c.err != nil {
+		return c.err
+	}
+	if !c.sessions[username] {
+		return db.NewError(db.ErrFailedPrecondition, "No active session")
+	}
+	return nil
+}
+
+func (c *fakeClient) PauseSharing(username string, until int64) error {
+	return c.err
+}
+
+// reportStream sends points to ReportLocation.
+type reportStream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	points []*pb.TrackingData
+}
+
+func (s *reportStream) Context() context.Context { return s.ctx }
+
+func (s *reportStream) Recv() (*pb.TrackingData, error) {
+	if len(s.points) == 0 {
+		return nil, io.EOF
+	}
+	point := s.points[0]
+	s.points = s.points[1:]
+	return point, nil
+}
+
+func (s *reportStream) SendAndClose(*pb.ReportLocationResponse) error { return nil }
+
+func newTestService(dbclient db.Client) *service {
+	return &service{dbclient: dbclient, sessions: make(map[string]pb.LocationTracker_StartTrackingServer)}
+}
+
+func as(username string) context.Context {
+	return auth.NewContext(context.Background(), auth.Identity{UserName: username})
+}
+
+// unary calls a handler through the status interceptor as the server does.
+func unary(ctx context.Context, handler func(ctx context.Context) (interface{}, error)) error {
+	_, err := UnaryStatusInterceptor(ctx, nil, &grpc.UnaryServerInfo{}, func(ctx context.Context, req interface{}) (interface{}, error) {
+		return handler(ctx)
+	})
+	return err
+}
+
+func TestHandlerCodes(t *testing.T) {
+	alice := &pb.TrackingData{TrackeeName: "alice", Longitude: 1, Latitude: 2, Timestamp: 3}
+	tests := []struct {
+		name     string
+		dbclient *fakeClient
+		call     func(s *service) error
+		code     codes.Code
+	}{
+		{"stop unknown subscription", &fakeClient{}, func(s *service) error {
+			return unary(as("bob"), func(ctx context.Context) (interface{}, error) {
+				return s.StopTracking(ctx, &pb.StopTrackingRequest{TrackeeName: "alice", UserName: "bob"})
+			})
+		}, codes.NotFound},
+		{"stop as another user", &fakeClient{}, func(s *service) error {
+			return unary(as("eve"), func(ctx context.Context) (interface{}, error) {
+				return s.StopTracking(ctx, &pb.StopTrackingRequest{TrackeeName: "alice", UserName: "bob"})
+			})
+		}, codes.PermissionDenied},
+		{"stop unauthenticated", &fakeClient{}, func(s *service) error {
+			return unary(context.Background(), func(ctx context.Context) (interface{}, error) {
+				return s.StopTracking(ctx, &pb.StopTrackingRequest{TrackeeName: "alice", UserName: "bob"})
+			})
+		}, codes.Unauthenticated},
+		{"report without session", &fakeClient{}, func(s *service) error {
+			return StreamStatusInterceptor(nil, nil, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				return s.ReportLocation(&reportStream{ctx: as("alice"), points: []*pb.TrackingData{alice}})
+			})
+		}, codes.FailedPrecondition},
+		{"report for another user", &fakeClient{sessions: map[string]bool{"alice": true}}, func(s *service) error {
+			return StreamStatusInterceptor(nil, nil, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				return s.ReportLocation(&reportStream{ctx: as("eve"), points: []*pb.TrackingData{alice}})
+			})
+		}, codes.PermissionDenied},
+		{"report in session", &fakeClient{sessions: map[string]bool{"alice": true}}, func(s *service) error {
+			return StreamStatusInterceptor(nil, nil, &grpc.StreamServerInfo{}, func(srv interface{}, stream grpc.ServerStream) error {
+				return s.ReportLocation(&reportStream{ctx: as("alice"), points: []*pb.TrackingData{alice}})
+			})
+		}, codes.OK},
+		{"backend failure", &fakeClient{err: errors.New("READONLY You can't write against a read only replica.")}, func(s *service) error {
+			return unary(as("alice"), func(ctx context.Context) (interface{}, error) {
+				return s.PauseSharing(ctx, &pb.PauseSharingRequest{UserName: "alice"})
+			})
+		}, codes.Internal},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			err := test.call(newTestService(test.dbclient))
+			st, _ := status.FromError(err)
+			if st.Code() != test.code {
+				t.Errorf("Code %s, want %s: %v", st.Code(), test.code, err)
+			}
+			if test.dbclient.err != nil && st.Message() == test.dbclient.err.Error() {
+				t.Errorf("Backend error %q sent to the client", st.Message())
+			}
+		})
+	}
+}
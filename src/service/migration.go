@@ -0,0 +1,30 @@
+package ltservice
+
+import (
+	"time"
+
+	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/settings"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// Migrate brings data stored by earlier versions up to date. It only has to
+// run once, before the server is upgraded, but running it again is harmless.
+func Migrate() error {
+	s := settings.NewSettings()
+	dbclient := db.NewClient()
+
+	start := time.Now()
+	total := 0
+	err := forEachOrgBatch(dbclient, s.BatchSize, "Migration", func(dbclient db.Client, cursor string, count int) (string, error) {
+		migrated, next, err := dbclient.MigrateSessions(cursor, count)
+		total += migrated
+		return next, err
+	})
+	if err != nil {
+		return err
+	}
+	logger.Infof("Migrated %d sessions in %s", total, time.Since(start))
+	return nil
+}
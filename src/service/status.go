@@ -0,0 +1,107 @@
+package ltservice
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"potpie.org/locationtracker/src/db"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// How long clients are asked to wait before retrying when the service is
+// unavailable.
+const retryDelay = time.Second
+
+// The gRPC code for each kind of error.
+var statusCodes = []struct {
+	kind error
+	code codes.Code
+}{
+	{context.Canceled, codes.Canceled},
+	{context.DeadlineExceeded, codes.DeadlineExceeded},
+	{db.ErrInvalidArgument, codes.InvalidArgument},
+	{db.ErrNotFound, codes.NotFound},
+	{db.ErrAlreadyExists, codes.AlreadyExists},
+	{db.ErrUnauthenticated, codes.Unauthenticated},
+	{db.ErrPermissionDenied, codes.PermissionDenied},
+	{db.ErrFailedPrecondition, codes.FailedPrecondition},
+	{db.ErrResourceExhausted, codes.ResourceExhausted},
+}
+
+// UnaryStatusInterceptor converts errors returned by the handlers, and by
+// the interceptors chained after it, to gRPC statuses.
+func UnaryStatusInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	resp, err := handler(ctx, req)
+	if err != nil {
+		return nil, statusError(err)
+	}
+	return resp, nil
+}
+
+func StreamStatusInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return statusError(handler(srv, stream))
+}
+
+// statusError converts err to a status with the code for its kind, errors
+// of no known kind are internal. Invalid arguments carry the field at
+// fault and unavailability a delay before retrying. Internal and unavailable
+// errors are logged and only described to clients in general terms, as they
+// come from the backend.
+func statusError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	code := codes.Internal
+	for _, s := range statusCodes {
+		if errors.Is(err, s.kind) {
+			code = s.code
+			break
+		}
+	}
+	var neterr net.Error
+	if code == codes.Internal && errors.As(err, &neterr) {
+		code = codes.Unavailable
+	}
+
+	details := []protoiface.MessageV1{}
+	var dberr *db.Error
+	if errors.As(err, &dberr) && dberr.Field != "" {
+		details = append(details, &errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{Field: dberr.Field, Description: dberr.Message}},
+		})
+	}
+	if code == codes.Unavailable {
+		details = append(details, &errdetails.RetryInfo{RetryDelay: durationpb.New(retryDelay)})
+	}
+
+	message := err.Error()
+	switch code {
+	case codes.Internal:
+		logger.Warn(err)
+		message = "Internal error"
+	case codes.Unavailable:
+		logger.Warn(err)
+		message = "Service unavailable"
+	}
+	st := status.New(code, message)
+	if len(details) > 0 {
+		if detailed, err := st.WithDetails(details...); err == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
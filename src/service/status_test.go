@@ -0,0 +1,90 @@
+package ltservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"potpie.org/locationtracker/src/db"
+)
+
+func TestStatusError(t *testing.T) {
+	tests := []struct {
+		name  string
+		err   error
+		code  codes.Code
+		field string
+		retry bool
+	}{
+		{"invalid argument", db.NewError(db.ErrInvalidArgument, "Bad request"), codes.InvalidArgument, "", false},
+		{"invalid field", db.InvalidField("userName", "Bad user name"), codes.InvalidArgument, "userName", false},
+		{"not found", db.NewError(db.ErrNotFound, "No such user"), codes.NotFound, "", false},
+		{"already exists", db.NewError(db.ErrAlreadyExists, "User exists"), codes.AlreadyExists, "", false},
+		{"unauthenticated", db.NewError(db.ErrUnauthenticated, "Not authenticated"), codes.Unauthenticated, "", false},
+		{"permission denied", db.NewError(db.ErrPermissionDenied, "Not allowed"), codes.PermissionDenied, "", false},
+		{"failed precondition", db.NewError(db.ErrFailedPrecondition, "No active session"), codes.FailedPrecondition, "", false},
+		{"resource exhausted", db.NewError(db.ErrResourceExhausted, "Too many"), codes.ResourceExhausted, "", false},
+		{"wrapped", fmt.Errorf("Register: %w", db.NewError(db.ErrAlreadyExists, "User exists")), codes.AlreadyExists, "", false},
+		{"canceled", context.Canceled, codes.Canceled, "", false},
+		{"deadline exceeded", context.DeadlineExceeded, codes.DeadlineExceeded, "", false},
+		{"unavailable", &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}, codes.Unavailable, "", true},
+		{"unknown", errors.New("Something broke"), codes.Internal, "", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			st, ok := status.FromError(statusError(test.err))
+			if !ok {
+				t.Fatalf("Not a status: %v", test.err)
+			}
+			if st.Code() != test.code {
+				t.Errorf("Code %s, want %s", st.Code(), test.code)
+			}
+			message := test.err.Error()
+			switch test.code {
+			case codes.Internal:
+				message = "Internal error"
+			case codes.Unavailable:
+				message = "Service unavailable"
+			}
+			if st.Message() != message {
+				t.Errorf("Message %q, want %q", st.Message(), message)
+			}
+			field, retry := "", false
+			for _, detail := range st.Details() {
+				switch d := detail.(type) {
+				case *errdetails.BadRequest:
+					if len(d.FieldViolations) != 1 {
+						t.Fatalf("Field violations %v", d.FieldViolations)
+					}
+					field = d.FieldViolations[0].Field
+				case *errdetails.RetryInfo:
+					retry = d.RetryDelay.AsDuration() == retryDelay
+				default:
+					t.Errorf("Unexpected detail %T", detail)
+				}
+			}
+			if field != test.field {
+				t.Errorf("Field %q, want %q", field, test.field)
+			}
+			if retry != test.retry {
+				t.Errorf("Retry info %v, want %v", retry, test.retry)
+			}
+		})
+	}
+}
+
+func TestStatusErrorKeepsStatus(t *testing.T) {
+	err := status.Error(codes.Aborted, "Aborted")
+	if statusError(err) != err {
+		t.Errorf("Status %v was converted", err)
+	}
+	if statusError(nil) != nil {
+		t.Errorf("nil was converted")
+	}
+}
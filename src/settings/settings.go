@@ -1,17 +1,77 @@
 package settings
 
 import (
+	"time"
+
 	"github.com/kelseyhightower/envconfig"
 	"google.golang.org/grpc"
 )
 
 type Settings struct {
 	// runtime options
-	GrpcUnaryInterceptor grpc.ServerOption
+	GrpcUnaryInterceptor  grpc.ServerOption
+	GrpcStreamInterceptor grpc.ServerOption
 	// env config
-	GrpcPort int    `envconfig:"GRPC_PORT" default:"8082"`
-	RedisUrl string `envconfig:"REDIS_URL" default:"localhost:6379"`
-	WSPort   int    `envconfig:"WS_PORT" default:"8081"`
+	GrpcPort        int           `envconfig:"GRPC_PORT" default:"8082"`
+	RedisUrl        string        `envconfig:"REDIS_URL" default:"localhost:6379"`
+	WSPort          int           `envconfig:"WS_PORT" default:"8081"`
+	TokenSecret     string        `envconfig:"TOKEN_SECRET"`
+	AccessTokenTTL  time.Duration `envconfig:"ACCESS_TOKEN_TTL" default:"15m"`
+	RefreshTokenTTL time.Duration `envconfig:"REFRESH_TOKEN_TTL" default:"720h"`
+	ShareLinkMaxTTL time.Duration `envconfig:"SHARE_LINK_MAX_TTL" default:"168h"`
+	MetricsPort     int           `envconfig:"METRICS_PORT" default:"8083"`
+	// WebSocket clients are pinged every WSPingInterval and dropped when
+	// nothing is heard from them for WSPongTimeout after that. Their access
+	// token is rechecked on each ping, and they are dropped once it has
+	// expired or been revoked or their user is disabled. Connections without
+	// subscriptions are closed after WSIdleTimeout without a request, 0 keeps
+	// them open.
+	WSPingInterval time.Duration `envconfig:"WS_PING_INTERVAL" default:"30s"`
+	WSPongTimeout  time.Duration `envconfig:"WS_PONG_TIMEOUT" default:"10s"`
+	WSWriteTimeout time.Duration `envconfig:"WS_WRITE_TIMEOUT" default:"10s"`
+	WSIdleTimeout  time.Duration `envconfig:"WS_IDLE_TIMEOUT" default:"10m"`
+	// requests on a WebSocket connection, subscriptions included, that may
+	// be handled at once
+	WSMaxRequests int `envconfig:"WS_MAX_REQUESTS" default:"64"`
+	// largest WebSocket message accepted in bytes, once inflated, larger ones
+	// close the connection
+	WSMaxMessageSize int64 `envconfig:"WS_MAX_MESSAGE_SIZE" default:"1048576"`
+	// WebSocket clients offering permessage-deflate have messages of at
+	// least WSCompressionThreshold bytes compressed at WSCompressionLevel,
+	// from 1 (fastest) to 9 (smallest), unless WSCompression is disabled.
+	WSCompression          bool `envconfig:"WS_COMPRESSION" default:"true"`
+	WSCompressionThreshold int  `envconfig:"WS_COMPRESSION_THRESHOLD" default:"1024"`
+	WSCompressionLevel     int  `envconfig:"WS_COMPRESSION_LEVEL" default:"6"`
+	// live updates wait in a queue of StreamQueueSize for each subscriber.
+	// When it is full StreamQueuePolicy drops the oldest update
+	// ("drop-oldest"), keeps only the latest position of each trackee
+	// ("coalesce") or ends the subscription ("disconnect").
+	StreamQueueSize   int    `envconfig:"STREAM_QUEUE_SIZE" default:"64"`
+	StreamQueuePolicy string `envconfig:"STREAM_QUEUE_POLICY" default:"coalesce"`
+	// users processed per batch by the janitor, compaction, key rotation and
+	// migrations, which pause between batches
+	BatchSize int `envconfig:"BATCH_SIZE" default:"100"`
+	// default retention, 0 keeps data forever. The janitor enforcing it, and
+	// the policies of organizations and users, only runs when one is set.
+	RetentionLocations time.Duration `envconfig:"RETENTION_LOCATIONS" default:"0"`
+	RetentionSessions  time.Duration `envconfig:"RETENTION_SESSIONS" default:"0"`
+	JanitorInterval    time.Duration `envconfig:"JANITOR_INTERVAL" default:"1h"`
+	// sessions older than CompactionAge are reduced in resolution, 0 disables
+	// compaction. The points dropped cannot be recovered. The spacing is in
+	// meters for the distance mode and in the units of the reported
+	// timestamps for the time mode.
+	CompactionAge       time.Duration `envconfig:"COMPACTION_AGE" default:"0"`
+	CompactionMode      string        `envconfig:"COMPACTION_MODE" default:"distance"`
+	CompactionSpacing   float64       `envconfig:"COMPACTION_SPACING" default:"50"`
+	CompactionTurnAngle float64       `envconfig:"COMPACTION_TURN_ANGLE" default:"30"`
+	CompactionInterval  time.Duration `envconfig:"COMPACTION_INTERVAL" default:"6h"`
+	// coordinates are encrypted at rest when a master key is set, either
+	// directly or in a file holding one key per line with the current key
+	// first. Keys are base64 encoded 32 byte AES keys, old keys are only used
+	// to read data keys wrapped before a rotation.
+	EncryptionKey     string   `envconfig:"ENCRYPTION_KEY"`
+	EncryptionKeyFile string   `envconfig:"ENCRYPTION_KEY_FILE"`
+	EncryptionOldKeys []string `envconfig:"ENCRYPTION_OLD_KEYS"`
 }
 
 type Option func(*Settings)
@@ -27,8 +87,16 @@ func NewSettings() Settings {
 	return s
 }
 
-func GrpcUnaryInterceptor(i grpc.UnaryServerInterceptor) Option {
+// GrpcUnaryInterceptor installs interceptors, the first of which is
+// outermost.
+func GrpcUnaryInterceptor(i ...grpc.UnaryServerInterceptor) Option {
+	return func(s *Settings) {
+		s.GrpcUnaryInterceptor = grpc.ChainUnaryInterceptor(i...)
+	}
+}
+
+func GrpcStreamInterceptor(i ...grpc.StreamServerInterceptor) Option {
 	return func(s *Settings) {
-		s.GrpcUnaryInterceptor = grpc.UnaryInterceptor(i)
+		s.GrpcStreamInterceptor = grpc.ChainStreamInterceptor(i...)
 	}
 }
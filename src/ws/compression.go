@@ -0,0 +1,70 @@
+package wsservice
+
+import (
+	"bytes"
+	"compress/flate"
+	"io"
+
+	"github.com/gobwas/ws"
+	"github.com/gobwas/ws/wsflate"
+
+	"potpie.org/locationtracker/src/settings"
+)
+
+// compression compresses the messages of connections that negotiated
+// permessage-deflate. Each message is compressed on its own, so neither side
+// keeps a window between messages.
+type compression struct {
+	threshold int
+	level     int
+}
+
+// newCompression reads the compression settings, it returns nil when
+// compression is disabled.
+func newCompression(s settings.Settings) (*compression, error) {
+	if !s.WSCompression {
+		return nil, nil
+	}
+	// check the level once rather than on every message
+	if _, err := flate.NewWriter(nil, s.WSCompressionLevel); err != nil {
+		return nil, err
+	}
+	return &compression{threshold: s.WSCompressionThreshold, level: s.WSCompressionLevel}, nil
+}
+
+func (c *compression) compressor(w io.Writer) wsflate.Compressor {
+	f, _ := flate.NewWriter(w, c.level)
+	return f
+}
+
+// compress compresses frame when its message is large enough to be worth it.
+func (c *compression) compress(frame ws.Frame) (ws.Frame, error) {
+	if len(frame.Payload) < c.threshold {
+		return frame, nil
+	}
+	var buf bytes.Buffer
+	w := wsflate.NewWriter(&buf, c.compressor)
+	if _, err := w.Write(frame.Payload); err != nil {
+		return frame, err
+	}
+	// the stream is flushed rather than closed, closing it would add a final
+	// block that permessage-deflate leaves out
+	if err := w.Flush(); err != nil {
+		return frame, err
+	}
+	header, err := wsflate.SetBit(frame.Header)
+	if err != nil {
+		return frame, err
+	}
+	frame.Header = header
+	frame.Payload = buf.Bytes()
+	frame.Header.Length = int64(len(frame.Payload))
+	return frame, nil
+}
+
+// decompress inflates a compressed message read from r.
+func (c *compression) decompress(r io.Reader) io.Reader {
+	return wsflate.NewReader(r, func(r io.Reader) wsflate.Decompressor {
+		return flate.NewReader(r)
+	})
+}
@@ -0,0 +1,223 @@
+package wsservice
+
+import (
+	"bufio"
+	"compress/flate"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gobwas/ws"
+	"github.com/gobwas/ws/wsflate"
+
+	"potpie.org/locationtracker/src/settings"
+)
+
+const (
+	testThreshold = 256
+	testMaxSize   = 64 * 1024
+	deflateOffer  = "permessage-deflate; server_no_context_takeover; client_no_context_takeover"
+)
+
+// pipeWriter hands the server end of an in-memory pipe to the upgrader.
+type pipeWriter struct {
+	conn   net.Conn
+	header http.Header
+	status int
+}
+
+func (w *pipeWriter) Header() http.Header         { return w.header }
+func (w *pipeWriter) Write(p []byte) (int, error) { return len(p), nil }
+func (w *pipeWriter) WriteHeader(status int)      { w.status = status }
+
+func (w *pipeWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	return w.conn, bufio.NewReadWriter(bufio.NewReader(w.conn), bufio.NewWriter(w.conn)), nil
+}
+
+func newTestService(t *testing.T, compress bool) *service {
+	s := settings.Settings{
+		WSMaxRequests:          8,
+		WSMaxMessageSize:       testMaxSize,
+		WSCompression:          compress,
+		WSCompressionThreshold: testThreshold,
+		WSCompressionLevel:     flate.BestSpeed,
+	}
+	c, err := newCompression(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &service{settings: s, compression: c, subscriptions: make(map[string]*subscription)}
+}
+
+type testClient struct {
+	conn     net.Conn
+	reader   *bufio.Reader
+	accepted bool
+}
+
+// dial upgrades a connection to s over a pipe, offering extensions unless
+// empty, and reports whether permessage-deflate was accepted.
+func dial(t *testing.T, s *service, extensions string) *testClient {
+	client, server := net.Pipe()
+	t.Cleanup(func() { client.Close() })
+	client.SetDeadline(time.Now().Add(5 * time.Second))
+
+	request := httptest.NewRequest("GET", "/", nil)
+	request.Header.Set("Upgrade", "websocket")
+	request.Header.Set("Connection", "Upgrade")
+	request.Header.Set("Sec-WebSocket-Version", "13")
+	request.Header.Set("Sec-WebSocket-Key", "dGhlIHNhbXBsZSBub25jZQ==")
+	if extensions != "" {
+		request.Header.Set("Sec-WebSocket-Extensions", extensions)
+	}
+	go func() {
+		conn, err := s.upgrade(&pipeWriter{conn: server, header: http.Header{}}, request, nil)
+		if err != nil {
+			server.Close()
+			return
+		}
+		s.serve(conn, s.HandleMsg)
+	}()
+
+	reader := bufio.NewReader(client)
+	response, err := http.ReadResponse(reader, request)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if response.StatusCode != http.StatusSwitchingProtocols {
+		t.Fatalf("Upgrade failed with %d", response.StatusCode)
+	}
+	accepted := strings.HasPrefix(response.Header.Get("Sec-WebSocket-Extensions"), wsflate.ExtensionName)
+	return &testClient{client, reader, accepted}
+}
+
+func (c *testClient) send(t *testing.T, msg []byte, compress bool) {
+	frame := ws.NewTextFrame(msg)
+	if compress {
+		var err error
+		if frame, err = (&compression{level: flate.BestSpeed}).compress(frame); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ws.WriteFrame(c.conn, ws.MaskFrameInPlace(frame)); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// receive returns the next message from the server and whether it was
+// compressed.
+func (c *testClient) receive(t *testing.T) (ws.Frame, bool) {
+	frame, err := ws.ReadFrame(c.reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compressed, err := wsflate.IsCompressed(frame.Header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if compressed {
+		if frame, err = wsflate.DecompressFrame(frame); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return frame, compressed
+}
+
+// request sends a request answered with an error echoing requestId, which
+// makes the response about as long as it.
+func request(requestId string) []byte {
+	msg, _ := json.Marshal(map[string]string{"RequestId": requestId, "RequestType": "NO_SUCH_REQUEST"})
+	return msg
+}
+
+func checkAnswer(t *testing.T, frame ws.Frame, requestId string) {
+	var response ErrorResponse
+	if err := json.Unmarshal(frame.Payload, &response); err != nil {
+		t.Fatalf("%v: %q", err, frame.Payload)
+	}
+	if response.RequestId != requestId || response.Code != CodeUnimplemented {
+		t.Errorf("Unexpected response %+v", response)
+	}
+}
+
+func TestCompressionInterop(t *testing.T) {
+	small := "small"
+	large := strings.Repeat("large", testThreshold)
+	tests := []struct {
+		name           string
+		serverCompress bool
+		offer          string
+		accepted       bool
+	}{
+		{"both compress", true, deflateOffer, true},
+		{"uncompressed client", true, "", false},
+		{"uncompressed server", false, deflateOffer, false},
+		{"failed negotiation", true, "permessage-deflate; server_max_window_bits=10", false},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			client := dial(t, newTestService(t, test.serverCompress), test.offer)
+			if client.accepted != test.accepted {
+				t.Fatalf("Accepted %v, want %v", client.accepted, test.accepted)
+			}
+			for _, requestId := range []string{small, large} {
+				client.send(t, request(requestId), client.accepted)
+				frame, compressed := client.receive(t)
+				checkAnswer(t, frame, requestId)
+				// only messages above the threshold are compressed
+				want := client.accepted && requestId == large
+				if compressed != want {
+					t.Errorf("Response of %d bytes compressed %v, want %v", len(frame.Payload), compressed, want)
+				}
+			}
+		})
+	}
+}
+
+func TestCompressionNotNegotiated(t *testing.T) {
+	client := dial(t, newTestService(t, false), deflateOffer)
+	// the server stops reading at the header, so the rest of the frame is
+	// written while the close frame is read
+	frame, err := (&compression{level: flate.BestSpeed}).compress(ws.NewTextFrame(request("compressed")))
+	if err != nil {
+		t.Fatal(err)
+	}
+	go ws.WriteFrame(client.conn, ws.MaskFrameInPlace(frame))
+	frame, _ = client.receive(t)
+	if frame.Header.OpCode != ws.OpClose {
+		t.Fatalf("Got opcode %d, want a close frame", frame.Header.OpCode)
+	}
+	if code, _ := ws.ParseCloseFrameData(frame.Payload); code != ws.StatusProtocolError {
+		t.Errorf("Closed with %d, want %d", code, ws.StatusProtocolError)
+	}
+}
+
+func TestCompressedInvalidUTF8(t *testing.T) {
+	client := dial(t, newTestService(t, true), deflateOffer)
+	client.send(t, []byte{'"', 0xff, 0xfe, '"'}, true)
+	frame, _ := client.receive(t)
+	if code, _ := ws.ParseCloseFrameData(frame.Payload); frame.Header.OpCode != ws.OpClose || code != ws.StatusInvalidFramePayloadData {
+		t.Errorf("Got opcode %d code %d, want close %d", frame.Header.OpCode, code, ws.StatusInvalidFramePayloadData)
+	}
+}
+
+func TestCompressedMessageTooBig(t *testing.T) {
+	client := dial(t, newTestService(t, true), deflateOffer)
+	// inflates to far more than it takes on the wire
+	frame, err := (&compression{level: flate.BestCompression}).compress(ws.NewTextFrame([]byte(strings.Repeat(" ", 16*testMaxSize))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if frame.Header.Length >= testMaxSize {
+		t.Fatalf("Compressed to %d bytes", frame.Header.Length)
+	}
+	go ws.WriteFrame(client.conn, ws.MaskFrameInPlace(frame))
+	frame, _ = client.receive(t)
+	if code, _ := ws.ParseCloseFrameData(frame.Payload); frame.Header.OpCode != ws.OpClose || code != ws.StatusMessageTooBig {
+		t.Errorf("Got opcode %d code %d, want close %d", frame.Header.OpCode, code, ws.StatusMessageTooBig)
+	}
+}
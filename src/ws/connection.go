@@ -0,0 +1,433 @@
+package wsservice
+
+import (
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"runtime/debug"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gobwas/ws"
+	"github.com/gobwas/ws/wsflate"
+	"github.com/gobwas/ws/wsutil"
+
+	"potpie.org/locationtracker/src/auth"
+	"potpie.org/locationtracker/src/db"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// connection is a WebSocket connection along with the user it has authenticated as
+// and the protocol version it speaks, compression is nil unless the client
+// negotiated permessage-deflate. Requests on a connection are handled
+// concurrently, so identity and subscriptions are guarded by lock and frames
+// are written under writeLock.
+type connection struct {
+	net.Conn
+	protocol      *protocol
+	compression   *compression
+	writeTimeout  time.Duration
+	lock          sync.Mutex
+	identity      *auth.Identity
+	subscriptions map[*subscription]bool
+	lastRequest   time.Time
+	writeLock     sync.Mutex
+	// done is closed once the connection is closed
+	done      chan struct{}
+	closeOnce sync.Once
+}
+
+// subscription is a watcher following a trackee or a group over a
+// connection. Subscriptions to a trackee are registered on the service under
+// key, where a later one replaces them. done is closed when the subscription
+// is replaced, stopped, or its connection closes.
+type subscription struct {
+	conn    *connection
+	key     string
+	done    chan struct{}
+	endOnce sync.Once
+}
+
+// errUnsubscribed ends monitoring for a subscription that is no longer
+// current, it is not reported to the client.
+var errUnsubscribed = errors.New("Unsubscribed")
+
+// errTooManyRequests answers a request made while the connection already has
+// as many requests in flight as it may.
+var errTooManyRequests = db.NewError(db.ErrResourceExhausted, "Too many requests in flight")
+
+var errMessageTooBig = errors.New("Message too big")
+
+func (this *service) newConnection(conn net.Conn, identity *auth.Identity, protocol *protocol) *connection {
+	return &connection{
+		Conn:          conn,
+		protocol:      protocol,
+		writeTimeout:  this.settings.WSWriteTimeout,
+		identity:      identity,
+		subscriptions: make(map[*subscription]bool),
+		lastRequest:   time.Now(),
+		done:          make(chan struct{}),
+	}
+}
+
+// requestConn is a connection as seen by one request, which is served with
+// the identity the connection had when the request arrived. Concurrent
+// requests that change the identity of the connection do not affect it.
+type requestConn struct {
+	*connection
+	identity *auth.Identity
+}
+
+// getIdentity returns the identity the request is served with.
+func (c *requestConn) getIdentity() *auth.Identity {
+	return c.identity
+}
+
+func (c *connection) getIdentity() *auth.Identity {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	return c.identity
+}
+
+func (c *connection) setIdentity(identity *auth.Identity) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	c.identity = identity
+}
+
+// expireIdentity clears identity unless another request has replaced it.
+func (c *connection) expireIdentity(identity *auth.Identity) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if c.identity == identity {
+		c.identity = nil
+	}
+}
+
+// write sends a message in a frame of the protocol, one writer at a time.
+func (c *connection) write(msg []byte) error {
+	frame := ws.NewFrame(c.protocol.opCode(), true, msg)
+	if c.compression != nil {
+		var err error
+		if frame, err = c.compression.compress(frame); err != nil {
+			return err
+		}
+	}
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	c.setWriteDeadline()
+	return ws.WriteFrame(c.Conn, frame)
+}
+
+// writeQueued sends a message taken from a queue.
+func (c *connection) writeQueued(msg interface{}) error {
+	return c.write(msg.([]byte))
+}
+
+func (c *connection) writeFrame(frame ws.Frame) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	c.setWriteDeadline()
+	return ws.WriteFrame(c.Conn, frame)
+}
+
+func (c *connection) setWriteDeadline() {
+	if c.writeTimeout > 0 {
+		c.Conn.SetWriteDeadline(time.Now().Add(c.writeTimeout))
+	}
+}
+
+// handleControl answers pings and close frames from the client. A close
+// frame is answered and reported as a wsutil.ClosedError.
+func (c *connection) handleControl(hdr ws.Header, r io.Reader) error {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+	c.setWriteDeadline()
+	handler := wsutil.ControlHandler{Src: r, Dst: c.Conn, State: ws.StateServerSide}
+	return handler.Handle(hdr)
+}
+
+// readMessage returns the next text or binary message, inflating it if it
+// is compressed and handling the control frames sent before it. Nothing may
+// be read for longer than timeout, and messages may be at most maxSize bytes
+// once inflated.
+func (c *connection) readMessage(timeout time.Duration, maxSize int64) ([]byte, error) {
+	var state wsflate.MessageState
+	reader := wsutil.Reader{
+		Source:         c.Conn,
+		State:          ws.StateServerSide,
+		CheckUTF8:      true,
+		OnIntermediate: c.handleControl,
+	}
+	if c.compression != nil {
+		reader.State |= ws.StateExtended
+		reader.Extensions = []wsutil.RecvExtension{&state}
+		// text is checked once inflated
+		reader.CheckUTF8 = false
+	}
+	for {
+		if timeout > 0 {
+			c.Conn.SetReadDeadline(time.Now().Add(timeout))
+		}
+		hdr, err := reader.NextFrame()
+		if err != nil {
+			return nil, err
+		}
+		if hdr.OpCode.IsControl() {
+			if err := c.handleControl(hdr, &reader); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		var r io.Reader = &reader
+		if state.IsCompressed() {
+			r = c.compression.decompress(&reader)
+		}
+		msg, err := ioutil.ReadAll(io.LimitReader(r, maxSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if int64(len(msg)) > maxSize {
+			return nil, errMessageTooBig
+		}
+		if c.compression != nil && hdr.OpCode == ws.OpText && !utf8.Valid(msg) {
+			return nil, wsutil.ErrInvalidUTF8
+		}
+		c.lock.Lock()
+		c.lastRequest = time.Now()
+		c.lock.Unlock()
+		return msg, nil
+	}
+}
+
+// idleSince returns when the connection last received a request, or the zero
+// time while it has subscriptions.
+func (c *connection) idleSince() time.Time {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	if len(c.subscriptions) > 0 {
+		return time.Time{}
+	}
+	return c.lastRequest
+}
+
+// close sends a close frame with the reason and closes the connection. Only
+// the first call has any effect.
+func (c *connection) close(code ws.StatusCode, reason string) {
+	c.closeOnce.Do(func() {
+		if code != 0 {
+			body := ws.NewCloseFrameBody(code, reason)
+			if err := c.writeFrame(ws.NewCloseFrame(body)); err != nil {
+				logger.Warn(err)
+			}
+		}
+		c.Conn.Close()
+		close(c.done)
+	})
+}
+
+func (s *subscription) end() {
+	s.endOnce.Do(func() {
+		close(s.done)
+	})
+}
+
+// subscribe adds a subscription for conn, which replaces the current one
+// under key unless key is empty.
+func (this *service) subscribe(conn *connection, key string) *subscription {
+	sub := &subscription{conn: conn, key: key, done: make(chan struct{})}
+	conn.lock.Lock()
+	conn.subscriptions[sub] = true
+	conn.lock.Unlock()
+	select {
+	case <-conn.done:
+		// closed while the request was being handled
+		sub.end()
+	default:
+	}
+	if key == "" {
+		return sub
+	}
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	if current, ok := this.subscriptions[key]; ok {
+		current.end()
+	}
+	this.subscriptions[key] = sub
+	return sub
+}
+
+// unsubscribe ends the subscription under key, reporting whether there was one.
+func (this *service) unsubscribe(key string) bool {
+	this.lock.Lock()
+	defer this.lock.Unlock()
+	sub, ok := this.subscriptions[key]
+	if ok {
+		sub.end()
+		delete(this.subscriptions, key)
+	}
+	return ok
+}
+
+func (s *subscription) active() bool {
+	select {
+	case <-s.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// release ends a subscription and forgets it, once monitoring has stopped.
+func (this *service) release(sub *subscription) {
+	sub.end()
+	this.lock.Lock()
+	if this.subscriptions[sub.key] == sub {
+		delete(this.subscriptions, sub.key)
+	}
+	this.lock.Unlock()
+	sub.conn.lock.Lock()
+	delete(sub.conn.subscriptions, sub)
+	sub.conn.lock.Unlock()
+}
+
+// serve reads requests from conn and passes them to handle until the
+// connection closes, then ends its subscriptions. Requests are ignored when
+// handle is nil. At most WSMaxRequests are handled at once, subscriptions
+// included, further requests are answered with RESOURCE_EXHAUSTED.
+func (this *service) serve(conn *connection, handle func(conn *connection, msg []byte)) {
+	defer this.closeConnection(conn, 0, "")
+	go this.keepAlive(conn)
+
+	maxRequests := this.settings.WSMaxRequests
+	if maxRequests < 1 {
+		maxRequests = 1
+	}
+	inFlight := make(chan struct{}, maxRequests)
+
+	timeout := this.settings.WSPingInterval + this.settings.WSPongTimeout
+	for {
+		msg, err := conn.readMessage(timeout, this.settings.WSMaxMessageSize)
+		if err != nil {
+			var closed wsutil.ClosedError
+			if errors.As(err, &closed) {
+				logger.Infof("Connection closed: %d %s", closed.Code, closed.Reason)
+				return
+			}
+			logger.Warn(err)
+			var protocolError ws.ProtocolError
+			if errors.As(err, &protocolError) {
+				this.closeConnection(conn, ws.StatusProtocolError, err.Error())
+			} else if err == wsutil.ErrInvalidUTF8 {
+				this.closeConnection(conn, ws.StatusInvalidFramePayloadData, err.Error())
+			} else if err == errMessageTooBig {
+				this.closeConnection(conn, ws.StatusMessageTooBig, err.Error())
+			}
+			return
+		}
+		if conn.protocol.binary {
+			logger.Infof("Msg read : %d bytes", len(msg))
+		} else {
+			logger.Infof("Msg read : %s", string(msg))
+		}
+		if handle == nil {
+			continue
+		}
+		select {
+		case inFlight <- struct{}{}:
+			// subscriptions run until they end, so that further requests
+			// are not held up behind them
+			go func(msg []byte) {
+				defer func() { <-inFlight }()
+				handleRecovered(conn, msg, handle)
+			}(msg)
+		default:
+			_, requestId, _ := conn.protocol.decode(msg)
+			if err := conn.send(errorResponse(requestId, errTooManyRequests)); err != nil {
+				logger.Warn(err)
+			}
+		}
+	}
+}
+
+// handleRecovered passes a request to handle, answering it with an INTERNAL
+// error rather than taking down the server when handle panics.
+func handleRecovered(conn *connection, msg []byte, handle func(conn *connection, msg []byte)) {
+	defer func() {
+		if r := recover(); r != nil {
+			logger.Errorf("Request handler panicked: %v\n%s", r, debug.Stack())
+			_, requestId, _ := conn.protocol.decode(msg)
+			if err := conn.send(errorResponse(requestId, errors.New("Internal error"))); err != nil {
+				logger.Warn(err)
+			}
+		}
+	}()
+	handle(conn, msg)
+}
+
+// keepAlive pings the client and closes the connection once it has been idle
+// for too long, or once the access token it authenticated with has expired
+// or been revoked or its user has been disabled.
+func (this *service) keepAlive(conn *connection) {
+	if this.settings.WSPingInterval <= 0 {
+		return
+	}
+	ticker := time.NewTicker(this.settings.WSPingInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-conn.done:
+			return
+		case now := <-ticker.C:
+			idle := conn.idleSince()
+			if this.settings.WSIdleTimeout > 0 && !idle.IsZero() && now.Sub(idle) >= this.settings.WSIdleTimeout {
+				this.closeConnection(conn, ws.StatusNormalClosure, "Idle timeout")
+				return
+			}
+			if err := this.checkIdentity(conn); err != nil {
+				this.closeConnection(conn, ws.StatusPolicyViolation, err.Error())
+				return
+			}
+			if err := conn.writeFrame(ws.NewPingFrame(nil)); err != nil {
+				logger.Warn(err)
+				this.closeConnection(conn, 0, "")
+				return
+			}
+		}
+	}
+}
+
+// checkIdentity rechecks the identity of conn so that its subscriptions do
+// not outlive its credentials. Failing to check is logged rather than closing
+// the connection.
+func (this *service) checkIdentity(conn *connection) error {
+	identity := conn.getIdentity()
+	if identity == nil {
+		return nil
+	}
+	err := identity.CheckActive(this.dbclient.ForOrg(identity.Org))
+	var dberr *db.Error
+	if err != nil && !errors.As(err, &dberr) {
+		logger.Warn(err)
+		return nil
+	}
+	return err
+}
+
+// closeConnection closes conn, sending a close frame unless code is 0, and
+// ends all its subscriptions.
+func (this *service) closeConnection(conn *connection, code ws.StatusCode, reason string) {
+	conn.close(code, reason)
+	conn.lock.Lock()
+	subs := []*subscription{}
+	for sub := range conn.subscriptions {
+		subs = append(subs, sub)
+	}
+	conn.lock.Unlock()
+	for _, sub := range subs {
+		this.release(sub)
+	}
+}
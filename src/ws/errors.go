@@ -0,0 +1,75 @@
+package wsservice
+
+import (
+	"encoding/json"
+	"errors"
+
+	"potpie.org/locationtracker/src/db"
+)
+
+// Codes of ERROR responses, named after their gRPC equivalents.
+const (
+	CodeInvalidArgument    = "INVALID_ARGUMENT"
+	CodeNotFound           = "NOT_FOUND"
+	CodeAlreadyExists      = "ALREADY_EXISTS"
+	CodeUnauthenticated    = "UNAUTHENTICATED"
+	CodePermissionDenied   = "PERMISSION_DENIED"
+	CodeFailedPrecondition = "FAILED_PRECONDITION"
+	CodeResourceExhausted  = "RESOURCE_EXHAUSTED"
+	CodeUnimplemented      = "UNIMPLEMENTED"
+	CodeInternal           = "INTERNAL"
+)
+
+// errUnimplemented is the kind of error for requests of an unknown type.
+var errUnimplemented = errors.New("unimplemented")
+
+// The code for each kind of error.
+var errorCodes = []struct {
+	kind error
+	code string
+}{
+	{db.ErrInvalidArgument, CodeInvalidArgument},
+	{db.ErrNotFound, CodeNotFound},
+	{db.ErrAlreadyExists, CodeAlreadyExists},
+	{db.ErrUnauthenticated, CodeUnauthenticated},
+	{db.ErrPermissionDenied, CodePermissionDenied},
+	{db.ErrFailedPrecondition, CodeFailedPrecondition},
+	{db.ErrResourceExhausted, CodeResourceExhausted},
+	{errUnimplemented, CodeUnimplemented},
+}
+
+// reportError is a location report that failed after storing count points.
+type reportError struct {
+	error
+	count int
+}
+
+func (e *reportError) Unwrap() error {
+	return e.error
+}
+
+// errorResponse describes err to the client, errors of no known kind are
+// internal. Malformed requests are invalid arguments.
+func errorResponse(requestId string, err error) ErrorResponse {
+	response := ErrorResponse{Type: ERROR, RequestId: requestId, Code: CodeInternal, Message: err.Error()}
+	for _, e := range errorCodes {
+		if errors.Is(err, e.kind) {
+			response.Code = e.code
+			break
+		}
+	}
+	var syntaxerr *json.SyntaxError
+	var typeerr *json.UnmarshalTypeError
+	if errors.As(err, &syntaxerr) || errors.As(err, &typeerr) {
+		response.Code = CodeInvalidArgument
+	}
+	var dberr *db.Error
+	if errors.As(err, &dberr) {
+		response.Field = dberr.Field
+	}
+	var reporterr *reportError
+	if errors.As(err, &reporterr) {
+		response.Count = reporterr.count
+	}
+	return response
+}
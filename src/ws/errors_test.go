@@ -0,0 +1,15 @@
+package wsservice
+
+import (
+	"testing"
+
+	"potpie.org/locationtracker/src/db"
+)
+
+func TestErrorResponseCountsStoredPoints(t *testing.T) {
+	err := &reportError{db.NewError(db.ErrFailedPrecondition, "No active session"), 2}
+	response := errorResponse("r1", err)
+	if response.Code != CodeFailedPrecondition || response.Count != 2 || response.Message != "No active session" {
+		t.Errorf("Unexpected response %+v", response)
+	}
+}
@@ -0,0 +1,273 @@
+package wsservice
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/runtime/protoiface"
+	"google.golang.org/protobuf/runtime/protoimpl"
+
+	pb "potpie.org/locationtracker/proto"
+	"potpie.org/locationtracker/src/db"
+)
+
+// protoMessage wraps a message of locationtracker.pb.go, which is generated
+// for the older protobuf API, for the proto package.
+func protoMessage(m protoiface.MessageV1) proto.Message {
+	return protoimpl.X.ProtoMessageV2Of(m)
+}
+
+// decodeProto unwraps a WebSocketRequest into the fields of the equivalent
+// JSON request, so that both are served the same way.
+func decodeProto(msg []byte) (map[string]json.RawMessage, string, error) {
+	var request pb.WebSocketRequest
+	if err := proto.Unmarshal(msg, protoMessage(&request)); err != nil {
+		return nil, "", db.NewError(db.ErrInvalidArgument, "Malformed request: %v", err)
+	}
+	requestType, payload, err := requestFromProto(&request)
+	if err != nil {
+		return nil, request.GetRequestId(), err
+	}
+	objmap := map[string]json.RawMessage{}
+	if objmap["RequestType"], err = json.Marshal(requestType); err != nil {
+		return nil, request.GetRequestId(), err
+	}
+	if payload != nil {
+		if objmap[reflect.TypeOf(payload).Name()], err = json.Marshal(payload); err != nil {
+			return nil, request.GetRequestId(), err
+		}
+	}
+	return objmap, request.GetRequestId(), nil
+}
+
+// requestFromProto returns the type and payload of the JSON request
+// equivalent to request, the payload is nil for requests without one.
+func requestFromProto(request *pb.WebSocketRequest) (RequestType, interface{}, error) {
+	switch r := request.GetRequest().(type) {
+	case *pb.WebSocketRequest_StartTracking:
+		return START_TRACKING, TrackingRequest{TrackeeName: r.StartTracking.GetTrackeeName(), UserName: r.StartTracking.GetUserName()}, nil
+	case *pb.WebSocketRequest_StopTracking:
+		return STOP_TRACKING, TrackingRequest{TrackeeName: r.StopTracking.GetTrackeeName(), UserName: r.StopTracking.GetUserName()}, nil
+	case *pb.WebSocketRequest_GetTrackables:
+		return GET_TRACKABLES, nil, nil
+	case *pb.WebSocketRequest_Register:
+		return REGISTER, RegisterRequest{Organization: r.Register.GetOrganization(), UserName: r.Register.GetUserName(), Password: r.Register.GetPassword(), IsTrackable: r.Register.GetTrackable()}, nil
+	case *pb.WebSocketRequest_GetSessionIds:
+		return GET_SESSION_IDS, SessionIdsRequest{UserName: r.GetSessionIds.GetUserName()}, nil
+	case *pb.WebSocketRequest_GetSessionData:
+		return GET_SESSION_DATA, SessionDataRequest{Id: r.GetSessionData.GetId()}, nil
+	case *pb.WebSocketRequest_Login:
+		return LOGIN, LoginRequest{Organization: r.Login.GetOrganization(), UserName: r.Login.GetUserName(), Password: r.Login.GetPassword()}, nil
+	case *pb.WebSocketRequest_RefreshToken:
+		return REFRESH_TOKEN, TokenRequest{Token: r.RefreshToken.GetRefreshToken()}, nil
+	case *pb.WebSocketRequest_RevokeToken:
+		return REVOKE_TOKEN, TokenRequest{Token: r.RevokeToken.GetRefreshToken()}, nil
+	case *pb.WebSocketRequest_ChangePassword:
+		return CHANGE_PASSWORD, ChangePasswordRequest{UserName: r.ChangePassword.GetUserName(), OldPassword: r.ChangePassword.GetOldPassword(), NewPassword: r.ChangePassword.GetNewPassword()}, nil
+	case *pb.WebSocketRequest_Authenticate:
+		return AUTHENTICATE, TokenRequest{Token: r.Authenticate.GetToken()}, nil
+	case *pb.WebSocketRequest_CreateApiKey:
+		return CREATE_API_KEY, ApiKeyRequest{UserName: r.CreateApiKey.GetUserName(), Label: r.CreateApiKey.GetLabel()}, nil
+	case *pb.WebSocketRequest_ListApiKeys:
+		return LIST_API_KEYS, ApiKeyRequest{UserName: r.ListApiKeys.GetUserName()}, nil
+	case *pb.WebSocketRequest_LabelApiKey:
+		return LABEL_API_KEY, ApiKeyRequest{UserName: r.LabelApiKey.GetUserName(), KeyId: r.LabelApiKey.GetKeyId(), Label: r.LabelApiKey.GetLabel()}, nil
+	case *pb.WebSocketRequest_RevokeApiKey:
+		return REVOKE_API_KEY, ApiKeyRequest{UserName: r.RevokeApiKey.GetUserName(), KeyId: r.RevokeApiKey.GetKeyId()}, nil
+	case *pb.WebSocketRequest_RequestTracking:
+		return REQUEST_TRACKING, TrackingRequest{TrackeeName: r.RequestTracking.GetTrackeeName(), UserName: r.RequestTracking.GetUserName()}, nil
+	case *pb.WebSocketRequest_ApproveTracking:
+		return APPROVE_TRACKING, TrackingRequest{TrackeeName: r.ApproveTracking.GetTrackeeName(), UserName: r.ApproveTracking.GetUserName()}, nil
+	case *pb.WebSocketRequest_DenyTracking:
+		return DENY_TRACKING, TrackingRequest{TrackeeName: r.DenyTracking.GetTrackeeName(), UserName: r.DenyTracking.GetUserName()}, nil
+	case *pb.WebSocketRequest_RevokeTracking:
+		return REVOKE_TRACKING, TrackingRequest{TrackeeName: r.RevokeTracking.GetTrackeeName(), UserName: r.RevokeTracking.GetUserName()}, nil
+	case *pb.WebSocketRequest_GetWatchers:
+		return GET_WATCHERS, WatchersRequest{UserName: r.GetWatchers.GetUserName()}, nil
+	case *pb.WebSocketRequest_CreateShareLink:
+		return CREATE_SHARE_LINK, ShareLinkRequest{UserName: r.CreateShareLink.GetUserName(), Duration: r.CreateShareLink.GetDurationSeconds(), SessionId: r.CreateShareLink.GetSessionId()}, nil
+	case *pb.WebSocketRequest_ListShareLinks:
+		return LIST_SHARE_LINKS, ShareLinkRequest{UserName: r.ListShareLinks.GetUserName()}, nil
+	case *pb.WebSocketRequest_RevokeShareLink:
+		return REVOKE_SHARE_LINK, ShareLinkRequest{UserName: r.RevokeShareLink.GetUserName(), ShareId: r.RevokeShareLink.GetShareId()}, nil
+	case *pb.WebSocketRequest_CreateGroup:
+		return CREATE_GROUP, GroupRequest{UserName: r.CreateGroup.GetUserName(), GroupName: r.CreateGroup.GetGroupName()}, nil
+	case *pb.WebSocketRequest_DeleteGroup:
+		return DELETE_GROUP, GroupRequest{UserName: r.DeleteGroup.GetUserName(), GroupName: r.DeleteGroup.GetGroupName()}, nil
+	case *pb.WebSocketRequest_ListGroups:
+		return LIST_GROUPS, GroupRequest{UserName: r.ListGroups.GetUserName()}, nil
+	case *pb.WebSocketRequest_AddGroupMember:
+		return ADD_GROUP_MEMBER, GroupRequest{UserName: r.AddGroupMember.GetUserName(), GroupName: r.AddGroupMember.GetGroupName(), TrackeeName: r.AddGroupMember.GetTrackeeName()}, nil
+	case *pb.WebSocketRequest_RemoveGroupMember:
+		return REMOVE_GROUP_MEMBER, GroupRequest{UserName: r.RemoveGroupMember.GetUserName(), GroupName: r.RemoveGroupMember.GetGroupName(), TrackeeName: r.RemoveGroupMember.GetTrackeeName()}, nil
+	case *pb.WebSocketRequest_StartGroupTracking:
+		return START_GROUP_TRACKING, GroupRequest{UserName: r.StartGroupTracking.GetUserName(), GroupName: r.StartGroupTracking.GetGroupName()}, nil
+	case *pb.WebSocketRequest_DeleteAccount:
+		return DELETE_ACCOUNT, AccountRequest{UserName: r.DeleteAccount.GetUserName(), Password: r.DeleteAccount.GetPassword()}, nil
+	case *pb.WebSocketRequest_ExportMyData:
+		return EXPORT_MY_DATA, AccountRequest{UserName: r.ExportMyData.GetUserName()}, nil
+	case *pb.WebSocketRequest_CreatePrivacyZone:
+		zone := r.CreatePrivacyZone.GetZone()
+		return CREATE_PRIVACY_ZONE, PrivacyZoneRequest{UserName: r.CreatePrivacyZone.GetUserName(), Zone: db.PrivacyZone{Name: zone.GetName(), Latitude: zone.GetLatitude(), Longitude: zone.GetLongitude(), Radius: zone.GetRadius(), Mode: zone.GetMode()}}, nil
+	case *pb.WebSocketRequest_ListPrivacyZones:
+		return LIST_PRIVACY_ZONES, PrivacyZoneRequest{UserName: r.ListPrivacyZones.GetUserName()}, nil
+	case *pb.WebSocketRequest_DeletePrivacyZone:
+		return DELETE_PRIVACY_ZONE, PrivacyZoneRequest{UserName: r.DeletePrivacyZone.GetUserName(), ZoneId: r.DeletePrivacyZone.GetZoneId()}, nil
+	case *pb.WebSocketRequest_SetTrackingPrecision:
+		return SET_TRACKING_PRECISION, PrecisionRequest{TrackeeName: r.SetTrackingPrecision.GetTrackeeName(), UserName: r.SetTrackingPrecision.GetUserName(), Precision: r.SetTrackingPrecision.GetPrecision()}, nil
+	case *pb.WebSocketRequest_PauseSharing:
+		return PAUSE_SHARING, PauseRequest{UserName: r.PauseSharing.GetUserName(), Until: r.PauseSharing.GetUntil()}, nil
+	case *pb.WebSocketRequest_ResumeSharing:
+		return RESUME_SHARING, PauseRequest{UserName: r.ResumeSharing.GetUserName()}, nil
+	case *pb.WebSocketRequest_GetAuditLog:
+		return GET_AUDIT_LOG, AuditLogRequest{UserName: r.GetAuditLog.GetUserName(), Since: r.GetAuditLog.GetSince(), Limit: int(r.GetAuditLog.GetLimit())}, nil
+	case *pb.WebSocketRequest_ReportLocation:
+		points := []LocationPoint{}
+		for _, td := range r.ReportLocation.GetTrackingData() {
+			points = append(points, LocationPoint{Longitude: td.GetLongitude(), Latitude: td.GetLatitude(), Timestamp: td.GetTimestamp()})
+		}
+		return REPORT_LOCATION, ReportLocationRequest{TrackeeName: r.ReportLocation.GetTrackeeName(), Points: points}, nil
+	case *pb.WebSocketRequest_StartSession:
+		return START_SESSION, SessionRequest{UserName: r.StartSession.GetUserName()}, nil
+	case *pb.WebSocketRequest_StopSession:
+		return STOP_SESSION, SessionRequest{UserName: r.StopSession.GetUserName()}, nil
+	}
+	return 0, nil, db.NewError(errUnimplemented, "Unknown request")
+}
+
+// encodeProto wraps a response in a WebSocketResponse.
+func encodeProto(message interface{}) ([]byte, error) {
+	response := &pb.WebSocketResponse{}
+	switch m := message.(type) {
+	case TrackablesResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Trackables{Trackables: &pb.GetTrackablesResponse{UserName: m.Trackables}}
+	case RegisterResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Registered{Registered: &pb.RegisterResponse{UserId: int64(m.Id)}}
+	case SessionIdsResponse:
+		results := []*pb.SessionId{}
+		for _, id := range m.Ids {
+			results = append(results, &pb.SessionId{Id: id.Id, Timestamp: id.Timestamp})
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_SessionIds{SessionIds: &pb.SessionIdsResponse{SessionId: results}}
+	case SessionDataResponse:
+		results := []*pb.TrackingData{}
+		for _, d := range m.Data {
+			results = append(results, &pb.TrackingData{Longitude: d.Longitude, Latitude: d.Latitude, Timestamp: d.Timestamp})
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_SessionData{SessionData: &pb.SessionDataResponse{TrackingData: results}}
+	case TrackingResponse:
+		td := m.TrackingData
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_TrackingData{TrackingData: &pb.TrackingData{TrackeeName: m.TrackeeName, Longitude: td.Longitude, Latitude: td.Latitude, Timestamp: td.Timestamp}}
+	case TrackingStatusResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_TrackingData{TrackingData: &pb.TrackingData{TrackeeName: m.TrackeeName, Status: m.Status, PausedUntil: m.Until}}
+	case TokensResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Tokens{Tokens: &pb.LoginResponse{AccessToken: m.AccessToken, RefreshToken: m.RefreshToken, ExpiresAt: m.ExpiresAt}}
+	case AuthenticatedResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Authenticated{Authenticated: &pb.WebSocketResponse_AuthenticatedResponse{UserName: m.UserName}}
+	case ApiKeyResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_ApiKey{ApiKey: &pb.CreateApiKeyResponse{ApiKey: apiKeyToProto(m.ApiKey), Key: m.Key}}
+	case ApiKeysResponse:
+		results := []*pb.ApiKey{}
+		for _, apikey := range m.ApiKeys {
+			results = append(results, apiKeyToProto(apikey))
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_ApiKeys{ApiKeys: &pb.ListApiKeysResponse{ApiKey: results}}
+	case WatchersResponse:
+		watchers := &pb.GetWatchersResponse{}
+		for _, w := range m.Pending {
+			watchers.Pending = append(watchers.Pending, &pb.Watcher{UserName: w.UserName, Timestamp: w.Timestamp})
+		}
+		for _, w := range m.Approved {
+			watchers.Approved = append(watchers.Approved, &pb.Watcher{UserName: w.UserName, Timestamp: w.Timestamp, Precision: w.Precision})
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Watchers{Watchers: watchers}
+	case ShareLinkResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_ShareLink{ShareLink: &pb.CreateShareLinkResponse{ShareLink: shareLinkToProto(m.ShareLink), Token: m.Token}}
+	case ShareLinksResponse:
+		results := []*pb.ShareLink{}
+		for _, sharelink := range m.ShareLinks {
+			results = append(results, shareLinkToProto(sharelink))
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_ShareLinks{ShareLinks: &pb.ListShareLinksResponse{ShareLink: results}}
+	case GroupResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Group{Group: &pb.CreateGroupResponse{Group: groupToProto(m.Group)}}
+	case GroupsResponse:
+		results := []*pb.Group{}
+		for _, g := range m.Groups {
+			results = append(results, groupToProto(g))
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Groups{Groups: &pb.ListGroupsResponse{Group: results}}
+	case ExportResponse:
+		data, err := json.MarshalIndent(m.Data, "", "  ")
+		if err != nil {
+			return nil, err
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Export{Export: &pb.ExportMyDataResponse{Data: data, ContentType: "application/json"}}
+	case PrivacyZoneResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_PrivacyZone{PrivacyZone: &pb.CreatePrivacyZoneResponse{Zone: privacyZoneToProto(m.Zone)}}
+	case PrivacyZonesResponse:
+		results := []*pb.PrivacyZone{}
+		for _, z := range m.Zones {
+			results = append(results, privacyZoneToProto(z))
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_PrivacyZones{PrivacyZones: &pb.ListPrivacyZonesResponse{Zone: results}}
+	case AuditLogResponse:
+		results := []*pb.AuditEntry{}
+		for _, e := range m.Entries {
+			results = append(results, &pb.AuditEntry{Timestamp: e.Timestamp, Actor: e.Actor, Target: e.Target, Action: e.Action, Transport: e.Transport, Outcome: e.Outcome, Reason: e.Reason})
+		}
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_AuditLog{AuditLog: &pb.GetAuditLogResponse{Entry: results}}
+	case OkResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Ok{Ok: &pb.WebSocketResponse_OkResponse{}}
+	case ErrorResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Error{Error: &pb.WebSocketResponse_ErrorResponse{Code: m.Code, Message: m.Message, Field: m.Field, Count: int32(m.Count)}}
+	case SessionResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_Session{Session: &pb.StartSessionResponse{SessionId: m.SessionId}}
+	case LocationsReportedResponse:
+		response.RequestId = m.RequestId
+		response.Response = &pb.WebSocketResponse_LocationsReported{LocationsReported: &pb.WebSocketResponse_LocationsReportedResponse{Count: int32(m.Count)}}
+	default:
+		return nil, fmt.Errorf("No protobuf message for %T", message)
+	}
+	return proto.Marshal(protoMessage(response))
+}
+
+func apiKeyToProto(apikey db.ApiKey) *pb.ApiKey {
+	return &pb.ApiKey{KeyId: apikey.Id, Label: apikey.Label, CreatedAt: apikey.CreatedAt, LastUsedAt: apikey.LastUsedAt}
+}
+
+func shareLinkToProto(sharelink db.ShareLink) *pb.ShareLink {
+	return &pb.ShareLink{ShareId: sharelink.Id, SessionId: sharelink.SessionId, CreatedAt: sharelink.CreatedAt, ExpiresAt: sharelink.ExpiresAt}
+}
+
+func groupToProto(g db.Group) *pb.Group {
+	return &pb.Group{GroupName: g.Name, Member: g.Members, CreatedAt: g.CreatedAt}
+}
+
+func privacyZoneToProto(z db.PrivacyZone) *pb.PrivacyZone {
+	return &pb.PrivacyZone{ZoneId: z.Id, Name: z.Name, Latitude: z.Latitude, Longitude: z.Longitude, Radius: z.Radius, Mode: z.Mode}
+}
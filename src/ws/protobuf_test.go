@@ -0,0 +1,100 @@
+package wsservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"google.golang.org/protobuf/proto"
+
+	pb "potpie.org/locationtracker/proto"
+	"potpie.org/locationtracker/src/db"
+)
+
+func TestProtoRequests(t *testing.T) {
+	decoded := map[RequestType]bool{}
+	for _, wrapper := range (*pb.WebSocketRequest)(nil).XXX_OneofWrappers() {
+		request := &pb.WebSocketRequest{RequestId: "r1"}
+		reflect.ValueOf(request).Elem().FieldByName("Request").Set(sample(reflect.TypeOf(wrapper)))
+		msg, err := proto.Marshal(protoMessage(request))
+		if err != nil {
+			t.Fatal(err)
+		}
+		objmap, requestId, err := decodeProto(msg)
+		if err != nil {
+			t.Errorf("%T: %v", wrapper, err)
+			continue
+		}
+		if requestId != "r1" {
+			t.Errorf("%T: request id %q", wrapper, requestId)
+		}
+		var requestType RequestType
+		if err := json.Unmarshal(objmap["RequestType"], &requestType); err != nil {
+			t.Errorf("%T: %v", wrapper, err)
+			continue
+		}
+		decoded[requestType] = true
+		payload, ok := requestPayloads[requestType]
+		if !ok {
+			if len(objmap) != 1 {
+				t.Errorf("%s: unexpected payload %v", requestType, objmap)
+			}
+			continue
+		}
+		// the payload must read back as the JSON handler expects it
+		payloadType := reflect.TypeOf(payload)
+		decoder := json.NewDecoder(bytes.NewReader(objmap[payloadType.Name()]))
+		decoder.DisallowUnknownFields()
+		value := reflect.New(payloadType)
+		if err := decoder.Decode(value.Interface()); err != nil {
+			t.Errorf("%s: %v", requestType, err)
+		} else if value.Elem().IsZero() {
+			t.Errorf("%s: empty %s", requestType, payloadType.Name())
+		}
+	}
+	for _, requestType := range sortedRequestTypes() {
+		if !decoded[requestType] {
+			t.Errorf("%s has no protobuf request", requestType)
+		}
+	}
+}
+
+func TestProtoUnknownRequest(t *testing.T) {
+	msg, err := proto.Marshal(protoMessage(&pb.WebSocketRequest{RequestId: "r1"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, requestId, err := decodeProto(msg)
+	var dbErr *db.Error
+	if !errors.As(err, &dbErr) || dbErr.Kind != errUnimplemented || requestId != "r1" {
+		t.Errorf("Got %v for %q", err, requestId)
+	}
+	if _, _, err := decodeProto([]byte{0xff}); err == nil {
+		t.Errorf("Malformed request decoded")
+	}
+}
+
+func TestProtoResponses(t *testing.T) {
+	for _, responseType := range sortedResponseTypes() {
+		response := sample(reflect.TypeOf(responseMessages[responseType]))
+		response.FieldByName("Type").Set(reflect.ValueOf(responseType))
+		msg, err := encodeProto(response.Interface())
+		if err != nil {
+			t.Errorf("%s: %v", responseType, err)
+			continue
+		}
+		var decoded pb.WebSocketResponse
+		if err := proto.Unmarshal(msg, protoMessage(&decoded)); err != nil {
+			t.Errorf("%s: %v", responseType, err)
+			continue
+		}
+		if decoded.GetRequestId() != "sample" {
+			t.Errorf("%s: request id %q", responseType, decoded.GetRequestId())
+		}
+		if decoded.GetResponse() == nil {
+			t.Errorf("%s: no response", responseType)
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package wsservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strconv"
+	"sync"
+
+	"github.com/gobwas/ws"
+	"github.com/gobwas/ws/wsflate"
+
+	"potpie.org/locationtracker/src/auth"
+	"potpie.org/locationtracker/src/db"
+)
+
+// Versions of the WebSocket protocol, negotiated with Sec-WebSocket-Protocol.
+// Clients that do not ask for one get the first version, which numbers its
+// message types. Later versions name them instead. Requests may give their
+// type either way whatever the version. The binary protocol sends the
+// WebSocketRequest and WebSocketResponse messages of locationtracker.proto
+// in binary frames instead.
+const (
+	ProtocolV1      = "locationtracker.v1"
+	ProtocolV2      = "locationtracker.v2"
+	ProtocolProtoV1 = "locationtracker.proto.v1"
+)
+
+type protocol struct {
+	name      string
+	typeNames bool
+	binary    bool
+}
+
+var protocols = map[string]*protocol{
+	ProtocolV1:      {name: ProtocolV1},
+	ProtocolV2:      {name: ProtocolV2, typeNames: true},
+	ProtocolProtoV1: {name: ProtocolProtoV1, binary: true},
+}
+
+// upgrade upgrades an HTTP request to a WebSocket connection speaking the
+// first protocol version offered by the client that is supported. Messages
+// are compressed when the client offers permessage-deflate, unless
+// compression is disabled.
+func (this *service) upgrade(writer http.ResponseWriter, request *http.Request, identity *auth.Identity) (*connection, error) {
+	var extension wsflate.Extension
+	upgrader := ws.HTTPUpgrader{
+		Protocol: func(name string) bool {
+			return protocols[name] != nil
+		},
+	}
+	if this.compression != nil {
+		extension.Parameters = wsflate.DefaultParameters
+		upgrader.Negotiate = extension.Negotiate
+	}
+	wsconn, _, hs, err := upgrader.Upgrade(request, writer)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := protocols[hs.Protocol]
+	if !ok {
+		p = protocols[ProtocolV1]
+	}
+	conn := this.newConnection(wsconn, identity, p)
+	if _, ok := extension.Accepted(); ok {
+		conn.compression = this.compression
+	}
+	return conn, nil
+}
+
+// decode unmarshals the fields of a request along with its id.
+func (p *protocol) decode(msg []byte) (map[string]json.RawMessage, string, error) {
+	if p.binary {
+		return decodeProto(msg)
+	}
+	var objmap map[string]json.RawMessage
+	var requestId string
+	err := json.Unmarshal(msg, &objmap)
+	if err == nil && objmap["RequestId"] != nil {
+		err = json.Unmarshal(objmap["RequestId"], &requestId)
+	}
+	return objmap, requestId, err
+}
+
+// encode marshals a message, naming its Type when the protocol calls for it.
+func (p *protocol) encode(message interface{}) ([]byte, error) {
+	if p.binary {
+		return encodeProto(message)
+	}
+	if p.typeNames {
+		message = withTypeName(message)
+	}
+	return json.Marshal(message)
+}
+
+// typeName is a response type marshalled by name.
+type typeName ResponseType
+
+func (t typeName) MarshalJSON() ([]byte, error) {
+	return json.Marshal(ResponseType(t).String())
+}
+
+var typeNameType = reflect.TypeOf(typeName(0))
+
+// namedResponses caches, for each response struct, the same struct with its
+// Type field a typeName.
+var namedResponses sync.Map
+
+// withTypeName copies a response into the struct that names its Type.
+func withTypeName(message interface{}) interface{} {
+	v := reflect.Indirect(reflect.ValueOf(message))
+	if v.Kind() != reflect.Struct {
+		return message
+	}
+	t := v.Type()
+	named, ok := namedResponses.Load(t)
+	if !ok {
+		fields := make([]reflect.StructField, t.NumField())
+		for i := range fields {
+			fields[i] = t.Field(i)
+			if fields[i].Type == responseTypeType {
+				fields[i].Type = typeNameType
+			}
+		}
+		named, _ = namedResponses.LoadOrStore(t, reflect.StructOf(fields))
+	}
+	copied := reflect.New(named.(reflect.Type)).Elem()
+	for i := 0; i < t.NumField(); i++ {
+		copied.Field(i).Set(v.Field(i).Convert(copied.Field(i).Type()))
+	}
+	return copied.Interface()
+}
+
+// opCode is the opcode of the frames messages are sent in.
+func (p *protocol) opCode() ws.OpCode {
+	if p.binary {
+		return ws.OpBinary
+	}
+	return ws.OpText
+}
+
+var requestTypeNames = map[RequestType]string{
+	START_TRACKING:         "START_TRACKING",
+	STOP_TRACKING:          "STOP_TRACKING",
+	GET_TRACKABLES:         "GET_TRACKABLES",
+	REGISTER:               "REGISTER",
+	GET_SESSION_IDS:        "GET_SESSION_IDS",
+	GET_SESSION_DATA:       "GET_SESSION_DATA",
+	LOGIN:                  "LOGIN",
+	REFRESH_TOKEN:          "REFRESH_TOKEN",
+	REVOKE_TOKEN:           "REVOKE_TOKEN",
+	CHANGE_PASSWORD:        "CHANGE_PASSWORD",
+	AUTHENTICATE:           "AUTHENTICATE",
+	CREATE_API_KEY:         "CREATE_API_KEY",
+	LIST_API_KEYS:          "LIST_API_KEYS",
+	LABEL_API_KEY:          "LABEL_API_KEY",
+	REVOKE_API_KEY:         "REVOKE_API_KEY",
+	REQUEST_TRACKING:       "REQUEST_TRACKING",
+	APPROVE_TRACKING:       "APPROVE_TRACKING",
+	DENY_TRACKING:          "DENY_TRACKING",
+	REVOKE_TRACKING:        "REVOKE_TRACKING",
+	GET_WATCHERS:           "GET_WATCHERS",
+	CREATE_SHARE_LINK:      "CREATE_SHARE_LINK",
+	LIST_SHARE_LINKS:       "LIST_SHARE_LINKS",
+	REVOKE_SHARE_LINK:      "REVOKE_SHARE_LINK",
+	CREATE_GROUP:           "CREATE_GROUP",
+	DELETE_GROUP:           "DELETE_GROUP",
+	LIST_GROUPS:            "LIST_GROUPS",
+	ADD_GROUP_MEMBER:       "ADD_GROUP_MEMBER",
+	REMOVE_GROUP_MEMBER:    "REMOVE_GROUP_MEMBER",
+	START_GROUP_TRACKING:   "START_GROUP_TRACKING",
+	DELETE_ACCOUNT:         "DELETE_ACCOUNT",
+	EXPORT_MY_DATA:         "EXPORT_MY_DATA",
+	CREATE_PRIVACY_ZONE:    "CREATE_PRIVACY_ZONE",
+	LIST_PRIVACY_ZONES:     "LIST_PRIVACY_ZONES",
+	DELETE_PRIVACY_ZONE:    "DELETE_PRIVACY_ZONE",
+	SET_TRACKING_PRECISION: "SET_TRACKING_PRECISION",
+	PAUSE_SHARING:          "PAUSE_SHARING",
+	RESUME_SHARING:         "RESUME_SHARING",
+	GET_AUDIT_LOG:          "GET_AUDIT_LOG",
+	REPORT_LOCATION:        "REPORT_LOCATION",
+	START_SESSION:          "START_SESSION",
+	STOP_SESSION:           "STOP_SESSION",
+}
+
+var responseTypeNames = map[ResponseType]string{
+	TRACKABLES:         "TRACKABLES",
+	REGISTER_ID:        "REGISTER_ID",
+	SESSION_IDS:        "SESSION_IDS",
+	SESSION_DATA:       "SESSION_DATA",
+	TRACKING_DATA:      "TRACKING_DATA",
+	TOKENS:             "TOKENS",
+	AUTHENTICATED:      "AUTHENTICATED",
+	API_KEY:            "API_KEY",
+	API_KEYS:           "API_KEYS",
+	WATCHERS:           "WATCHERS",
+	SHARE_LINK:         "SHARE_LINK",
+	SHARE_LINKS:        "SHARE_LINKS",
+	GROUP:              "GROUP",
+	GROUPS:             "GROUPS",
+	EXPORT:             "EXPORT",
+	PRIVACY_ZONE:       "PRIVACY_ZONE",
+	PRIVACY_ZONES:      "PRIVACY_ZONES",
+	TRACKING_STATUS:    "TRACKING_STATUS",
+	AUDIT_LOG:          "AUDIT_LOG",
+	OK:                 "OK",
+	ERROR:              "ERROR",
+	SESSION:            "SESSION",
+	LOCATIONS_REPORTED: "LOCATIONS_REPORTED",
+}
+
+func (t RequestType) String() string {
+	if name, ok := requestTypeNames[t]; ok {
+		return name
+	}
+	return strconv.Itoa(int(t))
+}
+
+// UnmarshalJSON accepts a request type by number or by name.
+func (t *RequestType) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err != nil {
+		var number int
+		if err := json.Unmarshal(data, &number); err != nil {
+			return db.InvalidField("RequestType", "RequestType must be a number or a name")
+		}
+		*t = RequestType(number)
+		return nil
+	}
+	for requestType, n := range requestTypeNames {
+		if n == name {
+			*t = requestType
+			return nil
+		}
+	}
+	return db.NewError(errUnimplemented, "Unknown request type %s", name)
+}
+
+func (t ResponseType) String() string {
+	if name, ok := responseTypeNames[t]; ok {
+		return name
+	}
+	return strconv.Itoa(int(t))
+}
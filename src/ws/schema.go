@@ -0,0 +1,233 @@
+package wsservice
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"sort"
+	"strings"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+// The JSON Schema of the protocol is served here. It is generated from the
+// message types below so that it cannot drift from them, the copy published
+// in proto/ is regenerated by running the server with "ws-schema".
+const schemaPath = "/schema.json"
+
+// The payload of each request, sent under a key named after its type.
+// Requests missing from here have no payload.
+var requestPayloads = map[RequestType]interface{}{
+	START_TRACKING:         TrackingRequest{},
+	STOP_TRACKING:          TrackingRequest{},
+	REGISTER:               RegisterRequest{},
+	GET_SESSION_IDS:        SessionIdsRequest{},
+	GET_SESSION_DATA:       SessionDataRequest{},
+	LOGIN:                  LoginRequest{},
+	REFRESH_TOKEN:          TokenRequest{},
+	REVOKE_TOKEN:           TokenRequest{},
+	CHANGE_PASSWORD:        ChangePasswordRequest{},
+	AUTHENTICATE:           TokenRequest{},
+	CREATE_API_KEY:         ApiKeyRequest{},
+	LIST_API_KEYS:          ApiKeyRequest{},
+	LABEL_API_KEY:          ApiKeyRequest{},
+	REVOKE_API_KEY:         ApiKeyRequest{},
+	REQUEST_TRACKING:       TrackingRequest{},
+	APPROVE_TRACKING:       TrackingRequest{},
+	DENY_TRACKING:          TrackingRequest{},
+	REVOKE_TRACKING:        TrackingRequest{},
+	GET_WATCHERS:           WatchersRequest{},
+	CREATE_SHARE_LINK:      ShareLinkRequest{},
+	LIST_SHARE_LINKS:       ShareLinkRequest{},
+	REVOKE_SHARE_LINK:      ShareLinkRequest{},
+	CREATE_GROUP:           GroupRequest{},
+	DELETE_GROUP:           GroupRequest{},
+	LIST_GROUPS:            GroupRequest{},
+	ADD_GROUP_MEMBER:       GroupRequest{},
+	REMOVE_GROUP_MEMBER:    GroupRequest{},
+	START_GROUP_TRACKING:   GroupRequest{},
+	DELETE_ACCOUNT:         AccountRequest{},
+	EXPORT_MY_DATA:         AccountRequest{},
+	CREATE_PRIVACY_ZONE:    PrivacyZoneRequest{},
+	LIST_PRIVACY_ZONES:     PrivacyZoneRequest{},
+	DELETE_PRIVACY_ZONE:    PrivacyZoneRequest{},
+	SET_TRACKING_PRECISION: PrecisionRequest{},
+	PAUSE_SHARING:          PauseRequest{},
+	RESUME_SHARING:         PauseRequest{},
+	GET_AUDIT_LOG:          AuditLogRequest{},
+	REPORT_LOCATION:        ReportLocationRequest{},
+	START_SESSION:          SessionRequest{},
+	STOP_SESSION:           SessionRequest{},
+}
+
+var responseMessages = map[ResponseType]interface{}{
+	TRACKABLES:         TrackablesResponse{},
+	REGISTER_ID:        RegisterResponse{},
+	SESSION_IDS:        SessionIdsResponse{},
+	SESSION_DATA:       SessionDataResponse{},
+	TRACKING_DATA:      TrackingResponse{},
+	TOKENS:             TokensResponse{},
+	AUTHENTICATED:      AuthenticatedResponse{},
+	API_KEY:            ApiKeyResponse{},
+	API_KEYS:           ApiKeysResponse{},
+	WATCHERS:           WatchersResponse{},
+	SHARE_LINK:         ShareLinkResponse{},
+	SHARE_LINKS:        ShareLinksResponse{},
+	GROUP:              GroupResponse{},
+	GROUPS:             GroupsResponse{},
+	EXPORT:             ExportResponse{},
+	PRIVACY_ZONE:       PrivacyZoneResponse{},
+	PRIVACY_ZONES:      PrivacyZonesResponse{},
+	TRACKING_STATUS:    TrackingStatusResponse{},
+	AUDIT_LOG:          AuditLogResponse{},
+	OK:                 OkResponse{},
+	ERROR:              ErrorResponse{},
+	SESSION:            SessionResponse{},
+	LOCATIONS_REPORTED: LocationsReportedResponse{},
+}
+
+type jsonSchema map[string]interface{}
+
+var (
+	requestTypeType  = reflect.TypeOf(RequestType(0))
+	responseTypeType = reflect.TypeOf(ResponseType(0))
+)
+
+// Schema returns the JSON Schema that every message sent either way
+// validates against. Message types are given by number or by name so that
+// the schema covers all JSON protocol versions.
+func Schema() ([]byte, error) {
+	definitions := jsonSchema{}
+
+	requests := []interface{}{}
+	for _, requestType := range sortedRequestTypes() {
+		properties := jsonSchema{
+			"RequestId":   jsonSchema{"type": "string"},
+			"RequestType": jsonSchema{"enum": []interface{}{int(requestType), requestType.String()}},
+		}
+		required := []string{"RequestType"}
+		if payload, ok := requestPayloads[requestType]; ok {
+			t := reflect.TypeOf(payload)
+			properties[t.Name()] = schemaFor(t, definitions)
+			required = append(required, t.Name())
+		}
+		requests = append(requests, jsonSchema{
+			"title":      requestType.String(),
+			"type":       "object",
+			"properties": properties,
+			"required":   required,
+		})
+	}
+
+	responses := []interface{}{}
+	for _, responseType := range sortedResponseTypes() {
+		t := reflect.TypeOf(responseMessages[responseType])
+		schema := structSchema(t, definitions)
+		schema["title"] = responseType.String()
+		schema["properties"].(jsonSchema)["Type"] = jsonSchema{"enum": []interface{}{int(responseType), responseType.String()}}
+		schema["required"] = []string{"Type"}
+		responses = append(responses, schema)
+	}
+
+	definitions["Request"] = jsonSchema{"oneOf": requests}
+	definitions["Response"] = jsonSchema{"oneOf": responses}
+	return json.MarshalIndent(jsonSchema{
+		"$schema":     "http://json-schema.org/draft-07/schema#",
+		"title":       "Location tracker WebSocket protocol",
+		"definitions": definitions,
+		"oneOf": []interface{}{
+			jsonSchema{"$ref": "#/definitions/Request"},
+			jsonSchema{"$ref": "#/definitions/Response"},
+		},
+	}, "", "  ")
+}
+
+// schemaFor describes how encoding/json marshals t. Named structs are added
+// to definitions and referred to.
+func schemaFor(t reflect.Type, definitions jsonSchema) jsonSchema {
+	switch t {
+	case requestTypeType, responseTypeType:
+		return jsonSchema{"type": []string{"integer", "string"}}
+	}
+	switch t.Kind() {
+	case reflect.Bool:
+		return jsonSchema{"type": "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return jsonSchema{"type": "integer"}
+	case reflect.Float32, reflect.Float64:
+		return jsonSchema{"type": "number"}
+	case reflect.String:
+		return jsonSchema{"type": "string"}
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return jsonSchema{"type": []string{"string", "null"}, "contentEncoding": "base64"}
+		}
+		return jsonSchema{"type": []string{"array", "null"}, "items": schemaFor(t.Elem(), definitions)}
+	case reflect.Array:
+		return jsonSchema{"type": "array", "items": schemaFor(t.Elem(), definitions)}
+	case reflect.Map:
+		return jsonSchema{"type": []string{"object", "null"}, "additionalProperties": schemaFor(t.Elem(), definitions)}
+	case reflect.Ptr:
+		return jsonSchema{"anyOf": []interface{}{schemaFor(t.Elem(), definitions), jsonSchema{"type": "null"}}}
+	case reflect.Struct:
+		if _, ok := definitions[t.Name()]; !ok {
+			// reserve the name first in case the type refers to itself
+			definitions[t.Name()] = nil
+			definitions[t.Name()] = structSchema(t, definitions)
+		}
+		return jsonSchema{"$ref": "#/definitions/" + t.Name()}
+	}
+	return jsonSchema{}
+}
+
+func structSchema(t reflect.Type, definitions jsonSchema) jsonSchema {
+	properties := jsonSchema{}
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if field.PkgPath != "" {
+			continue
+		}
+		name := field.Name
+		if tag := field.Tag.Get("json"); tag != "" {
+			if tag == "-" {
+				continue
+			}
+			if n := strings.Split(tag, ",")[0]; n != "" {
+				name = n
+			}
+		}
+		properties[name] = schemaFor(field.Type, definitions)
+	}
+	return jsonSchema{"type": "object", "properties": properties}
+}
+
+func sortedRequestTypes() []RequestType {
+	types := []RequestType{}
+	for requestType := range requestMethods {
+		types = append(types, requestType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+func sortedResponseTypes() []ResponseType {
+	types := []ResponseType{}
+	for responseType := range responseMessages {
+		types = append(types, responseType)
+	}
+	sort.Slice(types, func(i, j int) bool { return types[i] < types[j] })
+	return types
+}
+
+// HandleSchema serves the JSON Schema of the protocol.
+func (this *service) HandleSchema(writer http.ResponseWriter, request *http.Request) {
+	schema, err := Schema()
+	if err != nil {
+		logger.Warn(err)
+		http.Error(writer, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writer.Header().Set("Content-Type", "application/schema+json")
+	writer.Write(schema)
+}
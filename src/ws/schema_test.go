@@ -0,0 +1,140 @@
+package wsservice
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+const schemaFile = "../../proto/locationtracker.ws.schema.json"
+
+func compileSchema(t *testing.T) *jsonschema.Schema {
+	schema, err := Schema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiled, err := jsonschema.CompileString("schema.json", string(schema))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return compiled
+}
+
+// sample returns a value of t with every field set, so that nested
+// messages are validated too. The bookkeeping fields of generated protobuf
+// messages are left alone.
+func sample(t reflect.Type) reflect.Value {
+	v := reflect.New(t).Elem()
+	switch t.Kind() {
+	case reflect.Bool:
+		v.SetBool(true)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		v.SetInt(1)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		v.SetUint(1)
+	case reflect.Float32, reflect.Float64:
+		v.SetFloat(1.5)
+	case reflect.String:
+		v.SetString("sample")
+	case reflect.Slice:
+		v = reflect.Append(reflect.MakeSlice(t, 0, 1), sample(t.Elem()))
+	case reflect.Map:
+		v = reflect.MakeMap(t)
+		v.SetMapIndex(sample(t.Key()), sample(t.Elem()))
+	case reflect.Ptr:
+		v = reflect.New(t.Elem())
+		v.Elem().Set(sample(t.Elem()))
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			if f := t.Field(i); f.PkgPath == "" && !strings.HasPrefix(f.Name, "XXX_") {
+				v.Field(i).Set(sample(t.Field(i).Type))
+			}
+		}
+	}
+	return v
+}
+
+func validate(t *testing.T, schema *jsonschema.Schema, msg []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(msg, &v); err != nil {
+		t.Fatal(err)
+	}
+	return schema.Validate(v)
+}
+
+func TestSchemaRequests(t *testing.T) {
+	schema := compileSchema(t)
+	for _, requestType := range sortedRequestTypes() {
+		for _, typeValue := range []interface{}{int(requestType), requestType.String()} {
+			request := map[string]interface{}{"RequestId": "r1", "RequestType": typeValue}
+			if payload, ok := requestPayloads[requestType]; ok {
+				t := reflect.TypeOf(payload)
+				request[t.Name()] = sample(t).Interface()
+			}
+			msg, err := json.Marshal(request)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := validate(t, schema, msg); err != nil {
+				t.Errorf("%s: %v", msg, err)
+			}
+		}
+	}
+}
+
+func TestSchemaResponses(t *testing.T) {
+	schema := compileSchema(t)
+	for _, responseType := range sortedResponseTypes() {
+		response := sample(reflect.TypeOf(responseMessages[responseType]))
+		response.FieldByName("Type").Set(reflect.ValueOf(responseType))
+		for _, name := range []string{ProtocolV1, ProtocolV2} {
+			msg, err := protocols[name].encode(response.Interface())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := validate(t, schema, msg); err != nil {
+				t.Errorf("%s: %v", msg, err)
+			}
+			var decoded struct{ Type interface{} }
+			if err := json.Unmarshal(msg, &decoded); err != nil {
+				t.Fatal(err)
+			}
+			if _, named := decoded.Type.(string); named != protocols[name].typeNames {
+				t.Errorf("%s: Type %v in %s", name, decoded.Type, msg)
+			}
+		}
+	}
+}
+
+func TestSchemaRejects(t *testing.T) {
+	schema := compileSchema(t)
+	for _, msg := range []string{
+		`{"RequestType": "NO_SUCH_REQUEST"}`,
+		`{"RequestType": "START_TRACKING"}`,
+		`{"RequestType": "START_TRACKING", "TrackingRequest": {"TrackeeName": 1}}`,
+		`{"Type": "TRACKABLES", "Trackables": "alice"}`,
+	} {
+		if err := validate(t, schema, []byte(msg)); err == nil {
+			t.Errorf("%s validated", msg)
+		}
+	}
+}
+
+func TestSchemaFile(t *testing.T) {
+	schema, err := Schema()
+	if err != nil {
+		t.Fatal(err)
+	}
+	published, err := ioutil.ReadFile(schemaFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(append(schema, '\n'), published) {
+		t.Errorf("%s is out of date, regenerate it with go run ./src/bootstrap ws-schema", schemaFile)
+	}
+}
@@ -0,0 +1,133 @@
+package wsservice
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/gobwas/ws"
+
+	"potpie.org/locationtracker/src/audit"
+	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/privacy"
+
+	logger "github.com/sirupsen/logrus"
+)
+
+const sharePath = "/share/"
+
+// HandleShare streams the live location of the user behind a share link to
+// anyone holding its token, without requiring an account. Clients that ask for
+// a WebSocket upgrade receive TRACKING_DATA frames, anyone else receives the
+// same messages as server-sent events.
+func (this *service) HandleShare(writer http.ResponseWriter, request *http.Request) {
+	token := strings.TrimPrefix(request.URL.Path, sharePath)
+	sharelink, err := this.authenticator.AuthenticateShareLink(token)
+	if err != nil {
+		logger.Warn(err)
+		http.Error(writer, err.Error(), http.StatusUnauthorized)
+		return
+	}
+	dbclient := this.dbclient.ForOrg(sharelink.Org)
+	err = checkShareSession(dbclient, sharelink.UserName, sharelink.SessionId)
+	audit.Record(dbclient, "share:"+sharelink.Id, "Share", sharelink.UserName, db.TransportShare, err)
+	if err != nil {
+		logger.Warn(err)
+		http.Error(writer, err.Error(), http.StatusGone)
+		return
+	}
+
+	// event streams always use the first protocol version
+	protocol := protocols[ProtocolV1]
+	done := request.Context().Done()
+	var send func(msg []byte) error
+	if strings.EqualFold(request.Header.Get("Upgrade"), "websocket") {
+		conn, err := this.upgrade(writer, request, nil)
+		if err != nil {
+			logger.Warn(err)
+			return
+		}
+		protocol = conn.protocol
+		// the client is kept alive like any other, but nothing it sends
+		// is served
+		go this.serve(conn, nil)
+		defer this.closeConnection(conn, ws.StatusNormalClosure, "Share ended")
+		sub := this.subscribe(conn, "")
+		defer this.release(sub)
+		done = sub.done
+		send = conn.write
+	} else {
+		flusher, ok := writer.(http.Flusher)
+		if !ok {
+			http.Error(writer, "Streaming is not supported", http.StatusInternalServerError)
+			return
+		}
+		writer.Header().Set("Content-Type", "text/event-stream")
+		writer.Header().Set("Cache-Control", "no-cache")
+		writer.WriteHeader(http.StatusOK)
+		flusher.Flush()
+		send = func(msg []byte) error {
+			if _, err := fmt.Fprintf(writer, "data: %s\n\n", msg); err != nil {
+				return err
+			}
+			flusher.Flush()
+			return nil
+		}
+	}
+
+	q := this.queues.New(func(msg interface{}) error {
+		return send(msg.([]byte))
+	})
+	defer q.Close()
+	cb := func(update db.Update) error {
+		// the link may have been revoked or expired since the stream started
+		if _, err := this.authenticator.AuthenticateShareLink(token); err != nil {
+			return err
+		}
+		if err := checkShareSession(dbclient, sharelink.UserName, sharelink.SessionId); err != nil {
+			return err
+		}
+		if update.Status != "" {
+			response := TrackingStatusResponse{Type: TRACKING_STATUS, TrackeeName: sharelink.UserName, Status: update.Status, Until: update.Until}
+			msg, err := protocol.encode(response)
+			if err != nil {
+				return err
+			}
+			return q.Push("", msg)
+		}
+		td, err := dbclient.GetLocation(update.LocationKey)
+		if err != nil {
+			return err
+		}
+		td, visible, err := privacy.FilterLocation(dbclient, sharelink.UserName, "", td)
+		if err != nil || !visible {
+			return err
+		}
+		response := TrackingResponse{Type: TRACKING_DATA, TrackeeName: sharelink.UserName, TrackingData: td}
+		msg, err := protocol.encode(response)
+		if err != nil {
+			return err
+		}
+		return q.Push(sharelink.UserName, msg)
+	}
+
+	logger.Infof("Share: %s %s", sharelink.UserName, sharelink.Id)
+	err = dbclient.MonitorLocation(sharelink.UserName, "share:"+sharelink.Id, done, cb)
+	if err != nil {
+		logger.Warn(err)
+	}
+}
+
+func checkShareSession(dbclient db.Client, userName string, sessionId string) error {
+	if sessionId == "" {
+		return nil
+	}
+	current, err := dbclient.GetCurrentSession(userName)
+	if err != nil {
+		return err
+	}
+	if current != sessionId {
+		return db.NewError(db.ErrFailedPrecondition, "Shared session %s has ended", sessionId)
+	}
+	return nil
+}
@@ -2,13 +2,20 @@ package wsservice
 
 import (
 	"encoding/json"
-	"net"
+	"errors"
 	"net/http"
+	"strings"
+	"sync"
+	"time"
 
 	"github.com/gobwas/ws"
-	"github.com/gobwas/ws/wsutil"
 
+	"potpie.org/locationtracker/src/audit"
+	"potpie.org/locationtracker/src/auth"
 	"potpie.org/locationtracker/src/db"
+	"potpie.org/locationtracker/src/privacy"
+	"potpie.org/locationtracker/src/queue"
+	"potpie.org/locationtracker/src/settings"
 
 	logger "github.com/sirupsen/logrus"
 )
@@ -22,6 +29,41 @@ const (
 	REGISTER
 	GET_SESSION_IDS
 	GET_SESSION_DATA
+	LOGIN
+	REFRESH_TOKEN
+	REVOKE_TOKEN
+	CHANGE_PASSWORD
+	AUTHENTICATE
+	CREATE_API_KEY
+	LIST_API_KEYS
+	LABEL_API_KEY
+	REVOKE_API_KEY
+	REQUEST_TRACKING
+	APPROVE_TRACKING
+	DENY_TRACKING
+	REVOKE_TRACKING
+	GET_WATCHERS
+	CREATE_SHARE_LINK
+	LIST_SHARE_LINKS
+	REVOKE_SHARE_LINK
+	CREATE_GROUP
+	DELETE_GROUP
+	LIST_GROUPS
+	ADD_GROUP_MEMBER
+	REMOVE_GROUP_MEMBER
+	START_GROUP_TRACKING
+	DELETE_ACCOUNT
+	EXPORT_MY_DATA
+	CREATE_PRIVACY_ZONE
+	LIST_PRIVACY_ZONES
+	DELETE_PRIVACY_ZONE
+	SET_TRACKING_PRECISION
+	PAUSE_SHARING
+	RESUME_SHARING
+	GET_AUDIT_LOG
+	REPORT_LOCATION
+	START_SESSION
+	STOP_SESSION
 )
 
 type ResponseType int
@@ -32,8 +74,44 @@ const (
 	SESSION_IDS
 	SESSION_DATA
 	TRACKING_DATA
+	TOKENS
+	AUTHENTICATED
+	API_KEY
+	API_KEYS
+	WATCHERS
+	SHARE_LINK
+	SHARE_LINKS
+	GROUP
+	GROUPS
+	EXPORT
+	PRIVACY_ZONE
+	PRIVACY_ZONES
+	TRACKING_STATUS
+	AUDIT_LOG
+	OK
+	ERROR
+	SESSION
+	LOCATIONS_REPORTED
 )
 
+// OkResponse acknowledges a request that has no response of its own.
+type OkResponse struct {
+	Type      ResponseType
+	RequestId string
+}
+
+// ErrorResponse reports a failed request. Field names the request field at
+// fault for invalid arguments, and Count the number of points stored by a
+// location report that failed part way.
+type ErrorResponse struct {
+	Type      ResponseType
+	RequestId string
+	Code      string
+	Message   string
+	Field     string
+	Count     int `json:",omitempty"`
+}
+
 type TrackingRequest struct {
 	TrackeeName string
 	UserName    string
@@ -41,282 +119,1332 @@ type TrackingRequest struct {
 
 type TrackingResponse struct {
 	Type         ResponseType
+	RequestId    string
 	TrackeeName  string
 	TrackingData db.TrackingData
 }
 
+// TrackingStatusResponse tells watchers that a trackee paused or resumed
+// sharing. Until is 0 when the pause has no end.
+type TrackingStatusResponse struct {
+	Type        ResponseType
+	RequestId   string
+	TrackeeName string
+	Status      string
+	Until       int64
+}
+
 type TrackablesResponse struct {
 	Type       ResponseType
+	RequestId  string
 	Trackables []string
 }
 
 type RegisterRequest struct {
+	Organization string
+	UserName     string
+	Password     string
+	IsTrackable  bool
+}
+
+type RegisterResponse struct {
+	Type      ResponseType
+	RequestId string
+	Id        int
+}
+
+type SessionIdsRequest struct {
+	UserName string
+}
+
+type SessionIdsResponse struct {
+	Type      ResponseType
+	RequestId string
+	Ids       []db.SessionId
+}
+
+type SessionDataRequest struct {
+	Id string
+}
+
+type SessionDataResponse struct {
+	Type      ResponseType
+	RequestId string
+	Data      []db.TrackingData
+}
+
+type LoginRequest struct {
+	Organization string
+	UserName     string
+	Password     string
+}
+
+type TokenRequest struct {
+	Token string
+}
+
+type ChangePasswordRequest struct {
+	UserName    string
+	OldPassword string
+	NewPassword string
+}
+
+type TokensResponse struct {
+	Type         ResponseType
+	RequestId    string
+	AccessToken  string
+	RefreshToken string
+	ExpiresAt    int64
+}
+
+type AuthenticatedResponse struct {
+	Type      ResponseType
+	RequestId string
+	UserName  string
+}
+
+type ApiKeyRequest struct {
+	UserName string
+	KeyId    string
+	Label    string
+}
+
+type ApiKeyResponse struct {
+	Type      ResponseType
+	RequestId string
+	ApiKey    db.ApiKey
+	Key       string
+}
+
+type ApiKeysResponse struct {
+	Type      ResponseType
+	RequestId string
+	ApiKeys   []db.ApiKey
+}
+
+type PrecisionRequest struct {
+	TrackeeName string
 	UserName    string
-	IsTrackable bool
+	Precision   string
+}
+
+type PauseRequest struct {
+	UserName string
+	Until    int64
+}
+
+type WatchersRequest struct {
+	UserName string
+}
+
+type WatchersResponse struct {
+	Type      ResponseType
+	RequestId string
+	Pending   []db.Watcher
+	Approved  []db.Watcher
+}
+
+type ShareLinkRequest struct {
+	UserName  string
+	ShareId   string
+	Duration  int64
+	SessionId string
+}
+
+type ShareLinkResponse struct {
+	Type      ResponseType
+	RequestId string
+	ShareLink db.ShareLink
+	Token     string
+}
+
+type ShareLinksResponse struct {
+	Type       ResponseType
+	RequestId  string
+	ShareLinks []db.ShareLink
+}
+
+type GroupRequest struct {
+	UserName    string
+	GroupName   string
+	TrackeeName string
+}
+
+type GroupResponse struct {
+	Type      ResponseType
+	RequestId string
+	Group     db.Group
+}
+
+type GroupsResponse struct {
+	Type      ResponseType
+	RequestId string
+	Groups    []db.Group
+}
+
+type AccountRequest struct {
+	UserName string
+	Password string
+}
+
+type ExportResponse struct {
+	Type      ResponseType
+	RequestId string
+	Data      db.UserExport
+}
+
+// AuditLogRequest asks for up to Limit entries made at or after Since,
+// newest first.
+type AuditLogRequest struct {
+	UserName string
+	Since    int64
+	Limit    int
+}
+
+type AuditLogResponse struct {
+	Type      ResponseType
+	RequestId string
+	Entries   []db.AuditEntry
+}
+
+// Most points accepted in one location report.
+const maxReportedPoints = 1000
+
+// ReportLocationRequest reports one or more points for a trackee, which are
+// stored in order. When a point cannot be stored the points before it are
+// kept, and the ERROR response counts them so that the client can resume
+// from the first point not stored.
+type ReportLocationRequest struct {
+	TrackeeName string
+	Points      []LocationPoint
+}
+
+type LocationPoint struct {
+	Longitude float64
+	Latitude  float64
+	Timestamp int64
+}
+
+// LocationsReportedResponse acknowledges a location report once every point
+// has been stored.
+type LocationsReportedResponse struct {
+	Type      ResponseType
+	RequestId string
+	Count     int
+}
+
+type SessionRequest struct {
+	UserName string
+}
+
+type SessionResponse struct {
+	Type      ResponseType
+	RequestId string
+	SessionId string
+}
+
+type PrivacyZoneRequest struct {
+	UserName string
+	ZoneId   string
+	Zone     db.PrivacyZone
+}
+
+type PrivacyZoneResponse struct {
+	Type      ResponseType
+	RequestId string
+	Zone      db.PrivacyZone
+}
+
+type PrivacyZonesResponse struct {
+	Type      ResponseType
+	RequestId string
+	Zones     []db.PrivacyZone
+}
+
+type service struct {
+	dbclient      db.Client
+	authenticator auth.Authenticator
+	settings      settings.Settings
+	queues        queue.Config
+	// compression is nil when disabled
+	compression *compression
+	// lock guards subscriptions, which holds the current subscription of
+	// each watcher to each trackee
+	lock          sync.Mutex
+	subscriptions map[string]*subscription
+}
+
+// Requests that have no response of their own, which are acknowledged with
+// OK. Tracking requests are acknowledged once the subscription is in place.
+var acknowledged = map[RequestType]bool{
+	STOP_TRACKING:          true,
+	REVOKE_TOKEN:           true,
+	CHANGE_PASSWORD:        true,
+	LABEL_API_KEY:          true,
+	REVOKE_API_KEY:         true,
+	REQUEST_TRACKING:       true,
+	APPROVE_TRACKING:       true,
+	DENY_TRACKING:          true,
+	REVOKE_TRACKING:        true,
+	REVOKE_SHARE_LINK:      true,
+	DELETE_GROUP:           true,
+	ADD_GROUP_MEMBER:       true,
+	REMOVE_GROUP_MEMBER:    true,
+	DELETE_ACCOUNT:         true,
+	DELETE_PRIVACY_ZONE:    true,
+	SET_TRACKING_PRECISION: true,
+	PAUSE_SHARING:          true,
+	RESUME_SHARING:         true,
+	STOP_SESSION:           true,
+}
+
+// The gRPC method each request corresponds to, so that both transports are
+// subject to the same access policy.
+var requestMethods = map[RequestType]string{
+	START_TRACKING:         "StartTracking",
+	STOP_TRACKING:          "StopTracking",
+	GET_TRACKABLES:         "GetTrackables",
+	REGISTER:               "Register",
+	GET_SESSION_IDS:        "GetSessionIds",
+	GET_SESSION_DATA:       "GetSessionData",
+	LOGIN:                  "Login",
+	REFRESH_TOKEN:          "RefreshToken",
+	REVOKE_TOKEN:           "RevokeToken",
+	CHANGE_PASSWORD:        "ChangePassword",
+	AUTHENTICATE:           "Authenticate",
+	CREATE_API_KEY:         "CreateApiKey",
+	LIST_API_KEYS:          "ListApiKeys",
+	LABEL_API_KEY:          "LabelApiKey",
+	REVOKE_API_KEY:         "RevokeApiKey",
+	REQUEST_TRACKING:       "RequestTracking",
+	APPROVE_TRACKING:       "ApproveTracking",
+	DENY_TRACKING:          "DenyTracking",
+	REVOKE_TRACKING:        "RevokeTracking",
+	GET_WATCHERS:           "GetWatchers",
+	CREATE_SHARE_LINK:      "CreateShareLink",
+	LIST_SHARE_LINKS:       "ListShareLinks",
+	REVOKE_SHARE_LINK:      "RevokeShareLink",
+	CREATE_GROUP:           "CreateGroup",
+	DELETE_GROUP:           "DeleteGroup",
+	LIST_GROUPS:            "ListGroups",
+	ADD_GROUP_MEMBER:       "AddGroupMember",
+	REMOVE_GROUP_MEMBER:    "RemoveGroupMember",
+	START_GROUP_TRACKING:   "StartGroupTracking",
+	DELETE_ACCOUNT:         "DeleteAccount",
+	EXPORT_MY_DATA:         "ExportMyData",
+	CREATE_PRIVACY_ZONE:    "CreatePrivacyZone",
+	LIST_PRIVACY_ZONES:     "ListPrivacyZones",
+	DELETE_PRIVACY_ZONE:    "DeletePrivacyZone",
+	SET_TRACKING_PRECISION: "SetTrackingPrecision",
+	PAUSE_SHARING:          "PauseSharing",
+	RESUME_SHARING:         "ResumeSharing",
+	GET_AUDIT_LOG:          "GetAuditLog",
+	REPORT_LOCATION:        "ReportLocation",
+	START_SESSION:          "StartSession",
+	STOP_SESSION:           "StopSession",
+}
+
+// send writes a response to the client.
+func (c *connection) send(response interface{}) error {
+	msg, err := c.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	return c.write(msg)
+}
+
+func (c *requestConn) checkUser(username string) error {
+	identity := c.getIdentity()
+	if identity == nil {
+		return db.NewError(db.ErrUnauthenticated, "Not authenticated")
+	}
+	return identity.CheckUser(username)
+}
+
+// dbFor returns a client scoped to the organization the connection has
+// authenticated with.
+func (this *service) dbFor(conn *requestConn) db.Client {
+	identity := conn.getIdentity()
+	if identity == nil {
+		return this.dbclient
+	}
+	return this.dbclient.ForOrg(identity.Org)
+}
+
+func trackingKey(org string, trackeeName string, userName string) string {
+	return org + ":" + trackeeName + ":" + userName
+}
+
+// audit records an access over the connection to the data of target, which
+// was denied when err is set.
+func (this *service) audit(conn *requestConn, action string, target string, err error) {
+	actor := ""
+	if identity := conn.getIdentity(); identity != nil {
+		actor = identity.UserName
+	}
+	audit.Record(this.dbFor(conn), actor, action, target, db.TransportWebSocket, err)
+}
+
+func (this *service) checkWatcher(conn *requestConn, trackeeName string) error {
+	identity := conn.getIdentity()
+	if identity == nil {
+		return db.NewError(db.ErrUnauthenticated, "Not authenticated")
+	}
+	return identity.CheckWatcher(this.dbFor(conn), trackeeName)
+}
+
+func (this *service) StartTracking(trackeeName string, userName string, requestId string, conn *requestConn) error {
+	dbclient := this.dbFor(conn)
+	key := trackingKey(dbclient.Org(), trackeeName, userName)
+	sub := this.subscribe(conn.connection, key)
+	defer this.release(sub)
+	q := this.queues.New(conn.writeQueued)
+	defer q.Close()
+	err := dbclient.StartTracking(trackeeName, userName)
+	this.audit(conn, "StartTracking", trackeeName, err)
+	if err != nil {
+		return err
+	}
+
+	cb := func(update db.Update) error {
+		if !sub.active() {
+			return errUnsubscribed
+		}
+		approved, err := dbclient.IsWatcher(trackeeName, userName)
+		if err != nil {
+			return err
+		}
+		if !approved {
+			return db.NewError(db.ErrPermissionDenied, "User %s is no longer approved to track %s", userName, trackeeName)
+		}
+		if update.Status != "" {
+			return queueTrackingStatus(q, trackeeName, update, requestId, conn)
+		}
+		td, err := dbclient.GetLocation(update.LocationKey)
+		if err != nil {
+			return err
+		}
+		td, visible, err := privacy.FilterLocation(dbclient, trackeeName, userName, td)
+		if err != nil || !visible {
+			return err
+		}
+		logger.Infof("Location: %+v", td)
+		response := TrackingResponse{Type: TRACKING_DATA, RequestId: requestId, TrackeeName: trackeeName, TrackingData: td}
+		return queueResponse(q, trackeeName, response, conn)
+	}
+
+	logger.Infof("StartTracking: %s %s", trackeeName, userName)
+	if err = conn.send(OkResponse{Type: OK, RequestId: requestId}); err != nil {
+		return err
+	}
+	err = dbclient.MonitorLocation(trackeeName, userName, sub.done, cb)
+	return this.monitorEnded(conn, err)
+}
+
+func queueTrackingStatus(q *queue.Queue, trackeeName string, update db.Update, requestId string, conn *requestConn) error {
+	response := TrackingStatusResponse{Type: TRACKING_STATUS, RequestId: requestId, TrackeeName: trackeeName, Status: update.Status, Until: update.Until}
+	// status changes are never coalesced with positions
+	return queueResponse(q, "", response, conn)
+}
+
+// queueResponse queues a live update for the client, updates with the same
+// key may be coalesced.
+func queueResponse(q *queue.Queue, key string, response interface{}, conn *requestConn) error {
+	msg, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	return q.Push(key, msg)
+}
+
+// monitorEnded returns why a subscription ended, if the client should know.
+// Connections that cannot keep up with their updates are closed.
+func (this *service) monitorEnded(conn *requestConn, err error) error {
+	if errors.Is(err, queue.ErrSlowConsumer) {
+		this.closeConnection(conn.connection, ws.StatusPolicyViolation, err.Error())
+		return nil
+	}
+	if err == errUnsubscribed {
+		return nil
+	}
+	return err
+}
+
+func (this *service) StopTracking(trackeeName string, userName string, conn *requestConn) error {
+	logger.Infof("StopTracking: %s %s", trackeeName, userName)
+	key := trackingKey(this.dbFor(conn).Org(), trackeeName, userName)
+	if !this.unsubscribe(key) {
+		return db.NewError(db.ErrNotFound, "User %s is not tracking %s", userName, trackeeName)
+	}
+	err := this.dbFor(conn).StopTracking(trackeeName, userName)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (this *service) GetTrackables(requestId string, conn *requestConn) error {
+	logger.Infof("GetTrackables")
+
+	trackables, err := this.dbFor(conn).GetTrackables()
+	if err != nil {
+		return err
+	}
+	response := TrackablesResponse{Type: TRACKABLES, RequestId: requestId, Trackables: trackables}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (this *service) Register(org string, userName string, password string, isTrackage bool, requestId string, conn *requestConn) error {
+	logger.Infof("Register: %s %s %t", org, userName, isTrackage)
+
+	if err := auth.CheckRegistration(conn.getIdentity(), org); err != nil {
+		return err
+	}
+	exists, err := this.dbclient.OrganizationExists(org)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return db.NewError(db.ErrNotFound, "Organization %s does not exist", org)
+	}
+	id, err := this.dbclient.ForOrg(org).Register(userName, password, isTrackage)
+	if err != nil {
+		return err
+	}
+	response := RegisterResponse{Type: REGISTER_ID, RequestId: requestId, Id: id}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (this *service) GetSessionIds(userName string, requestId string, conn *requestConn) error {
+	logger.Infof("GetSessionIds: %s", userName)
+
+	ids, err := this.dbFor(conn).GetSessionIds(userName)
+	if err != nil {
+		return err
+	}
+	response := SessionIdsResponse{Type: SESSION_IDS, RequestId: requestId, Ids: ids}
+
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (this *service) GetSessionData(id string, owner string, requestId string, conn *requestConn) error {
+	logger.Infof("GetSessionData: %s", id)
+
+	data, err := this.dbFor(conn).GetSessionData(id)
+	if err != nil {
+		return err
+	}
+	data, err = privacy.Filter(this.dbFor(conn), owner, conn.getIdentity().UserName, data)
+	if err != nil {
+		return err
+	}
+	response := SessionDataResponse{Type: SESSION_DATA, RequestId: requestId, Data: data}
+
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (this *service) Login(org string, userName string, password string, requestId string, conn *requestConn) error {
+	logger.Infof("Login: %s %s", org, userName)
+
+	tokens, err := this.authenticator.Login(org, userName, password)
+	if err != nil {
+		return err
+	}
+	return this.sendTokens(tokens, requestId, conn)
+}
+
+func (this *service) RefreshToken(refreshToken string, requestId string, conn *requestConn) error {
+	logger.Infof("RefreshToken")
+
+	tokens, err := this.authenticator.Refresh(refreshToken)
+	if err != nil {
+		return err
+	}
+	return this.sendTokens(tokens, requestId, conn)
+}
+
+func (this *service) RevokeToken(refreshToken string, conn *requestConn) error {
+	logger.Infof("RevokeToken")
+
+	err := this.authenticator.Revoke(conn.getIdentity(), refreshToken)
+	if err != nil {
+		return err
+	}
+	conn.setIdentity(nil)
+	return nil
+}
+
+func (this *service) ChangePassword(userName string, oldPassword string, newPassword string, conn *requestConn) error {
+	logger.Infof("ChangePassword: %s", userName)
+
+	err := this.dbFor(conn).ChangePassword(userName, oldPassword, newPassword)
+	if err != nil {
+		return err
+	}
+	conn.setIdentity(nil)
+	return nil
+}
+
+func (this *service) Authenticate(accessToken string, requestId string, conn *requestConn) error {
+	identity, err := this.authenticator.Authenticate(accessToken)
+	if err != nil {
+		return err
+	}
+	conn.setIdentity(&identity)
+	logger.Infof("Authenticate: %s", identity.UserName)
+
+	response := AuthenticatedResponse{Type: AUTHENTICATED, RequestId: requestId, UserName: identity.UserName}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (this *service) CreateApiKey(userName string, label string, requestId string, conn *requestConn) error {
+	logger.Infof("CreateApiKey: %s %s", userName, label)
+
+	apikey, key, err := this.authenticator.CreateApiKey(this.dbFor(conn).Org(), userName, label)
+	if err != nil {
+		return err
+	}
+	response := ApiKeyResponse{Type: API_KEY, RequestId: requestId, ApiKey: apikey, Key: key}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (this *service) ListApiKeys(userName string, requestId string, conn *requestConn) error {
+	logger.Infof("ListApiKeys: %s", userName)
+
+	apikeys, err := this.dbFor(conn).GetApiKeys(userName)
+	if err != nil {
+		return err
+	}
+	response := ApiKeysResponse{Type: API_KEYS, RequestId: requestId, ApiKeys: apikeys}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
 }
 
-type RegisterResponse struct {
-	Type ResponseType
-	Id   int
+func (this *service) LabelApiKey(userName string, keyId string, label string, conn *requestConn) error {
+	logger.Infof("LabelApiKey: %s %s %s", userName, keyId, label)
+
+	return this.dbFor(conn).LabelApiKey(userName, keyId, label)
 }
 
-type SessionIdsRequest struct {
-	UserName string
+func (this *service) RevokeApiKey(userName string, keyId string, conn *requestConn) error {
+	logger.Infof("RevokeApiKey: %s %s", userName, keyId)
+
+	return this.dbFor(conn).RevokeApiKey(userName, keyId)
 }
 
-type SessionIdsResponse struct {
-	Type ResponseType
-	Ids  []db.SessionId
+func (this *service) GetWatchers(userName string, requestId string, conn *requestConn) error {
+	logger.Infof("GetWatchers: %s", userName)
+
+	pending, approved, err := this.dbFor(conn).GetWatchers(userName)
+	if err != nil {
+		return err
+	}
+	response := WatchersResponse{Type: WATCHERS, RequestId: requestId, Pending: pending, Approved: approved}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
 }
 
-type SessionDataRequest struct {
-	Id int64
+func (this *service) CreateShareLink(userName string, duration int64, sessionId string, requestId string, conn *requestConn) error {
+	logger.Infof("CreateShareLink: %s %d %s", userName, duration, sessionId)
+
+	sharelink, token, err := this.authenticator.CreateShareLink(this.dbFor(conn).Org(), userName, time.Duration(duration)*time.Second, sessionId)
+	if err != nil {
+		return err
+	}
+	response := ShareLinkResponse{Type: SHARE_LINK, RequestId: requestId, ShareLink: sharelink, Token: token}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
 }
 
-type SessionDataResponse struct {
-	Type ResponseType
-	Data []db.TrackingData
+func (this *service) ListShareLinks(userName string, requestId string, conn *requestConn) error {
+	logger.Infof("ListShareLinks: %s", userName)
+
+	sharelinks, err := this.dbFor(conn).GetShareLinks(userName)
+	if err != nil {
+		return err
+	}
+	response := ShareLinksResponse{Type: SHARE_LINKS, RequestId: requestId, ShareLinks: sharelinks}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
 }
 
-type service struct {
-	dbclient    db.Client
-	connections map[string]net.Conn
+func (this *service) CreateGroup(userName string, groupName string, requestId string, conn *requestConn) error {
+	logger.Infof("CreateGroup: %s %s", userName, groupName)
+
+	group, err := this.dbFor(conn).CreateGroup(userName, groupName)
+	if err != nil {
+		return err
+	}
+	response := GroupResponse{Type: GROUP, RequestId: requestId, Group: group}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
 }
 
-func (this *service) StartTracking(trackeeName string, userName string, conn net.Conn) error {
-	_, ok := this.connections[trackeeName+":"+userName]
-	if ok {
-		delete(this.connections, trackeeName+":"+userName)
+func (this *service) ListGroups(userName string, requestId string, conn *requestConn) error {
+	logger.Infof("ListGroups: %s", userName)
+
+	groups, err := this.dbFor(conn).GetGroups(userName)
+	if err != nil {
+		return err
 	}
-	this.connections[trackeeName+":"+userName] = conn
-	err := this.dbclient.StartTracking(trackeeName, userName)
+	response := GroupsResponse{Type: GROUPS, RequestId: requestId, Groups: groups}
+	json, err := conn.protocol.encode(response)
 	if err != nil {
 		return err
 	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
+	return nil
+}
 
-	cb := func(locationkey string) error {
-		td, err := this.dbclient.GetLocation(locationkey)
+func (this *service) StartGroupTracking(groupName string, userName string, requestId string, conn *requestConn) error {
+	dbclient := this.dbFor(conn)
+	sub := this.subscribe(conn.connection, "")
+	defer this.release(sub)
+	q := this.queues.New(conn.writeQueued)
+	defer q.Close()
+	// members are audited when the first of their updates is delivered
+	audited := make(map[string]bool)
+	cb := func(trackeeName string, update db.Update) error {
+		approved, err := dbclient.IsWatcher(trackeeName, userName)
 		if err != nil {
 			return err
 		}
-		logger.Infof("Location: %+v", td)
-		response := TrackingResponse{Type: TRACKING_DATA, TrackeeName: trackeeName, TrackingData: td}
-		msg, err := json.Marshal(response)
+		if !approved {
+			// Consent was withdrawn for this member only, keep following the others
+			return nil
+		}
+		if !audited[trackeeName] {
+			this.audit(conn, "StartGroupTracking", trackeeName, nil)
+			audited[trackeeName] = true
+		}
+		if update.Status != "" {
+			return queueTrackingStatus(q, trackeeName, update, requestId, conn)
+		}
+		td, err := dbclient.GetLocation(update.LocationKey)
 		if err != nil {
 			return err
 		}
-		if err := wsutil.WriteServerMessage(conn, ws.OpText, msg); err != nil {
+		td, visible, err := privacy.FilterLocation(dbclient, trackeeName, userName, td)
+		if err != nil || !visible {
 			return err
 		}
-		return nil
+		response := TrackingResponse{Type: TRACKING_DATA, RequestId: requestId, TrackeeName: trackeeName, TrackingData: td}
+		return queueResponse(q, trackeeName, response, conn)
 	}
 
-	logger.Infof("StartTracking: %s %s", trackeeName, userName)
-	err = this.dbclient.MonitorLocation(trackeeName, userName, cb)
+	logger.Infof("StartGroupTracking: %s %s", groupName, userName)
+	groups, err := dbclient.GetGroups(userName)
 	if err != nil {
 		return err
 	}
-	return nil
+	for _, group := range groups {
+		if group.Name == groupName {
+			if err = conn.send(OkResponse{Type: OK, RequestId: requestId}); err != nil {
+				return err
+			}
+			err = dbclient.MonitorGroup(userName, groupName, sub.done, cb)
+			return this.monitorEnded(conn, err)
+		}
+	}
+	return db.NewError(db.ErrNotFound, "Group %s does not exist", groupName)
 }
 
-func (this *service) StopTracking(trackeeName string, userName string, conn net.Conn) error {
-	logger.Infof("StopTracking: %s %s", trackeeName, userName)
-	_, ok := this.connections[trackeeName+":"+userName]
-	if !ok {
-		return nil
-	}
-	delete(this.connections, trackeeName+":"+userName)
-	err := this.dbclient.StopTracking(trackeeName, userName)
+func (this *service) DeleteAccount(userName string, password string, conn *requestConn) error {
+	logger.Infof("DeleteAccount: %s", userName)
+
+	err := this.dbFor(conn).DeleteUser(userName, password)
 	if err != nil {
 		return err
 	}
-
+	conn.setIdentity(nil)
 	return nil
 }
 
-func (this *service) GetTrackables(conn net.Conn) error {
-	logger.Infof("GetTrackables")
+func (this *service) ExportMyData(userName string, requestId string, conn *requestConn) error {
+	logger.Infof("ExportMyData: %s", userName)
+	this.audit(conn, "ExportMyData", userName, nil)
 
-	this.dbclient.GetTrackables()
-	trackables, err := this.dbclient.GetTrackables()
+	export, err := this.dbFor(conn).ExportUser(userName)
 	if err != nil {
 		return err
 	}
-	response := TrackablesResponse{Type: TRACKABLES, Trackables: trackables}
-	json, err := json.Marshal(response)
+	response := ExportResponse{Type: EXPORT, RequestId: requestId, Data: export}
+	json, err := conn.protocol.encode(response)
 	if err != nil {
 		return err
 	}
-	if err := wsutil.WriteServerMessage(conn, ws.OpText, json); err != nil {
+	if err := conn.write(json); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (this *service) Register(userName string, isTrackage bool, conn net.Conn) error {
-	logger.Infof("Register: %s %s", userName, isTrackage)
+func (this *service) CreatePrivacyZone(userName string, zone db.PrivacyZone, requestId string, conn *requestConn) error {
+	logger.Infof("CreatePrivacyZone: %s %s", userName, zone.Name)
 
-	id, err := this.dbclient.Register(userName, isTrackage)
+	zone, err := this.dbFor(conn).CreatePrivacyZone(userName, zone)
 	if err != nil {
 		return err
 	}
-	response := RegisterResponse{Type: REGISTER_ID, Id: id}
-	json, err := json.Marshal(response)
+	response := PrivacyZoneResponse{Type: PRIVACY_ZONE, RequestId: requestId, Zone: zone}
+	json, err := conn.protocol.encode(response)
 	if err != nil {
 		return err
 	}
-	if err := wsutil.WriteServerMessage(conn, ws.OpText, json); err != nil {
+	if err := conn.write(json); err != nil {
 		return err
 	}
 	return nil
 }
 
-func (this *service) GetSessionIds(userName string, conn net.Conn) error {
-	logger.Infof("GetSessionIds: %s", userName)
+func (this *service) ListPrivacyZones(userName string, requestId string, conn *requestConn) error {
+	logger.Infof("ListPrivacyZones: %s", userName)
 
-	ids, err := this.dbclient.GetSessionIds(userName)
+	zones, err := this.dbFor(conn).GetPrivacyZones(userName)
 	if err != nil {
 		return err
 	}
-	response := SessionIdsResponse{Type: SESSION_IDS, Ids: ids}
-
-	json, err := json.Marshal(response)
+	response := PrivacyZonesResponse{Type: PRIVACY_ZONES, RequestId: requestId, Zones: zones}
+	json, err := conn.protocol.encode(response)
 	if err != nil {
 		return err
 	}
-	if err := wsutil.WriteServerMessage(conn, ws.OpText, json); err != nil {
+	if err := conn.write(json); err != nil {
 		return err
 	}
+	return nil
+}
 
+func (this *service) GetAuditLog(userName string, since int64, limit int, requestId string, conn *requestConn) error {
+	entries, err := this.dbFor(conn).GetAuditLog(userName, since, audit.Limit(limit))
+	if err != nil {
+		return err
+	}
+	response := AuditLogResponse{Type: AUDIT_LOG, RequestId: requestId, Entries: entries}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
+		return err
+	}
 	return nil
 }
 
-func (this *service) GetSessionData(id int64, conn net.Conn) error {
-	logger.Infof("GetSessionData: %d", id)
+func (this *service) ReportLocation(trackeeName string, points []LocationPoint, requestId string, conn *requestConn) error {
+	if len(points) == 0 {
+		return db.InvalidField("Points", "No points reported")
+	}
+	if len(points) > maxReportedPoints {
+		return db.InvalidField("Points", "At most %d points may be reported at once", maxReportedPoints)
+	}
+	for i, point := range points {
+		err := this.dbFor(conn).ReportLocation(trackeeName, point.Longitude, point.Latitude, point.Timestamp)
+		if err != nil {
+			return &reportError{err, i}
+		}
+	}
+	response := LocationsReportedResponse{Type: LOCATIONS_REPORTED, RequestId: requestId, Count: len(points)}
+	return conn.send(response)
+}
 
-	data, err := this.dbclient.GetSessionData(id)
+func (this *service) StartSession(userName string, requestId string, conn *requestConn) error {
+	sessionid, err := this.dbFor(conn).StartSession(userName)
 	if err != nil {
 		return err
 	}
-	response := SessionDataResponse{Type: SESSION_DATA, Data: data}
+	response := SessionResponse{Type: SESSION, RequestId: requestId, SessionId: sessionid}
+	return conn.send(response)
+}
 
-	json, err := json.Marshal(response)
+func (this *service) sendTokens(tokens auth.Tokens, requestId string, conn *requestConn) error {
+	identity, err := this.authenticator.Authenticate(tokens.AccessToken)
 	if err != nil {
 		return err
 	}
-	if err := wsutil.WriteServerMessage(conn, ws.OpText, json); err != nil {
+	conn.setIdentity(&identity)
+
+	response := TokensResponse{Type: TOKENS, RequestId: requestId, AccessToken: tokens.AccessToken, RefreshToken: tokens.RefreshToken, ExpiresAt: tokens.ExpiresAt}
+	json, err := conn.protocol.encode(response)
+	if err != nil {
+		return err
+	}
+	if err := conn.write(json); err != nil {
 		return err
 	}
-
 	return nil
 }
 
-func (this *service) HandleMsg(conn net.Conn, msg []byte) {
-	var objmap map[string]json.RawMessage
-	err := json.Unmarshal([]byte(msg), &objmap)
+// HandleMsg serves a request, which is answered with its typed response,
+// with OK when it has none, or with ERROR when it fails. Responses carry the
+// RequestId the client gave the request.
+//
+// Requests on a connection are served concurrently, so responses may arrive
+// in any order. Clients should wait for the answer to a request that changes
+// the identity of the connection before sending requests that rely on it.
+func (this *service) HandleMsg(conn *connection, msg []byte) {
+	objmap, requestId, err := conn.protocol.decode(msg)
+	if err == nil {
+		var reqType RequestType
+		if err = json.Unmarshal(objmap["RequestType"], &reqType); err == nil {
+			err = this.handleRequest(conn, requestId, reqType, objmap)
+			if err == nil && acknowledged[reqType] {
+				err = conn.send(OkResponse{Type: OK, RequestId: requestId})
+			}
+		}
+	}
 	if err != nil {
 		logger.Warn(err)
-		return
+		if err := conn.send(errorResponse(requestId, err)); err != nil {
+			logger.Warn(err)
+		}
 	}
+}
 
-	var reqType RequestType
-	err = json.Unmarshal(objmap["RequestType"], &reqType)
-	if err != nil {
-		logger.Warn(err)
-		return
+func (this *service) handleRequest(c *connection, requestId string, reqType RequestType, objmap map[string]json.RawMessage) error {
+	method, ok := requestMethods[reqType]
+	if !ok {
+		return db.NewError(errUnimplemented, "Unknown request type %d", reqType)
+	}
+	identity := c.getIdentity()
+	if identity != nil && identity.ExpiresAt != 0 && time.Now().Unix() >= identity.ExpiresAt {
+		c.expireIdentity(identity)
+		identity = nil
 	}
+	if err := auth.Authorize(identity, method); err != nil {
+		return err
+	}
+	conn := &requestConn{c, identity}
+	var err error
 	switch reqType {
 	case START_TRACKING:
 		var tr TrackingRequest
 		err = json.Unmarshal(objmap["TrackingRequest"], &tr)
 		if err != nil {
-			logger.Warn(err)
-			return
+			return err
 		}
-		err = this.StartTracking(tr.TrackeeName, tr.UserName, conn)
-		if err != nil {
-			logger.Warn(err)
+		if err = conn.checkUser(tr.UserName); err != nil {
+			return err
 		}
-		break
+		return this.StartTracking(tr.TrackeeName, tr.UserName, requestId, conn)
 	case STOP_TRACKING:
 		var tr TrackingRequest
 		err = json.Unmarshal(objmap["TrackingRequest"], &tr)
 		if err != nil {
-			logger.Warn(err)
-			return
+			return err
 		}
-		err = this.StopTracking(tr.TrackeeName, tr.UserName, conn)
-		if err != nil {
-			logger.Warn(err)
+		if err = conn.checkUser(tr.UserName); err != nil {
+			return err
 		}
-		break
+		return this.StopTracking(tr.TrackeeName, tr.UserName, conn)
 	case GET_TRACKABLES:
-		err = this.GetTrackables(conn)
-		if err != nil {
-			logger.Warn(err)
-		}
-		break
+		return this.GetTrackables(requestId, conn)
 	case REGISTER:
 		var rr RegisterRequest
 		err = json.Unmarshal(objmap["RegisterRequest"], &rr)
 		if err != nil {
-			logger.Warn(err)
-			return
-		}
-		err = this.Register(rr.UserName, rr.IsTrackable, conn)
-		if err != nil {
-			logger.Warn(err)
+			return err
 		}
-		break
+		return this.Register(rr.Organization, rr.UserName, rr.Password, rr.IsTrackable, requestId, conn)
 	case GET_SESSION_IDS:
 		var sir SessionIdsRequest
 		err = json.Unmarshal(objmap["SessionIdsRequest"], &sir)
 		if err != nil {
-			logger.Warn(err)
-			return
+			return err
 		}
-		err = this.GetSessionIds(sir.UserName, conn)
+		err = this.checkWatcher(conn, sir.UserName)
+		this.audit(conn, "GetSessionIds", sir.UserName, err)
 		if err != nil {
-			logger.Warn(err)
+			return err
 		}
-		break
+		return this.GetSessionIds(sir.UserName, requestId, conn)
 	case GET_SESSION_DATA:
 		var sdr SessionDataRequest
 		err = json.Unmarshal(objmap["SessionDataRequest"], &sdr)
 		if err != nil {
-			logger.Warn(err)
-			return
+			return err
 		}
-		err = this.GetSessionData(sdr.Id, conn)
+		owner, err := this.dbFor(conn).GetSessionOwner(sdr.Id)
 		if err != nil {
-			logger.Warn(err)
+			return err
+		}
+		err = this.checkWatcher(conn, owner)
+		this.audit(conn, "GetSessionData", owner, err)
+		if err != nil {
+			return err
+		}
+		return this.GetSessionData(sdr.Id, owner, requestId, conn)
+	case LOGIN:
+		var lr LoginRequest
+		err = json.Unmarshal(objmap["LoginRequest"], &lr)
+		if err != nil {
+			return err
+		}
+		return this.Login(lr.Organization, lr.UserName, lr.Password, requestId, conn)
+	case REFRESH_TOKEN:
+		var tr TokenRequest
+		err = json.Unmarshal(objmap["TokenRequest"], &tr)
+		if err != nil {
+			return err
+		}
+		return this.RefreshToken(tr.Token, requestId, conn)
+	case REVOKE_TOKEN:
+		var tr TokenRequest
+		err = json.Unmarshal(objmap["TokenRequest"], &tr)
+		if err != nil {
+			return err
+		}
+		return this.RevokeToken(tr.Token, conn)
+	case CHANGE_PASSWORD:
+		var cpr ChangePasswordRequest
+		err = json.Unmarshal(objmap["ChangePasswordRequest"], &cpr)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(cpr.UserName); err != nil {
+			return err
+		}
+		return this.ChangePassword(cpr.UserName, cpr.OldPassword, cpr.NewPassword, conn)
+	case AUTHENTICATE:
+		var tr TokenRequest
+		err = json.Unmarshal(objmap["TokenRequest"], &tr)
+		if err != nil {
+			return err
+		}
+		return this.Authenticate(tr.Token, requestId, conn)
+	case CREATE_API_KEY, LIST_API_KEYS, LABEL_API_KEY, REVOKE_API_KEY:
+		var ar ApiKeyRequest
+		err = json.Unmarshal(objmap["ApiKeyRequest"], &ar)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(ar.UserName); err != nil {
+			return err
+		}
+		switch reqType {
+		case CREATE_API_KEY:
+			err = this.CreateApiKey(ar.UserName, ar.Label, requestId, conn)
+		case LIST_API_KEYS:
+			err = this.ListApiKeys(ar.UserName, requestId, conn)
+		case LABEL_API_KEY:
+			err = this.LabelApiKey(ar.UserName, ar.KeyId, ar.Label, conn)
+		case REVOKE_API_KEY:
+			err = this.RevokeApiKey(ar.UserName, ar.KeyId, conn)
+		}
+		return err
+	case REQUEST_TRACKING, APPROVE_TRACKING, DENY_TRACKING, REVOKE_TRACKING:
+		var tr TrackingRequest
+		err = json.Unmarshal(objmap["TrackingRequest"], &tr)
+		if err != nil {
+			return err
+		}
+		switch reqType {
+		case REQUEST_TRACKING:
+			err = conn.checkUser(tr.UserName)
+		case APPROVE_TRACKING, DENY_TRACKING:
+			err = conn.checkUser(tr.TrackeeName)
+		case REVOKE_TRACKING:
+			// Either the trackee or the watcher may end the relationship
+			if err = conn.checkUser(tr.TrackeeName); err != nil {
+				err = conn.checkUser(tr.UserName)
+			}
+		}
+		if err != nil {
+			return err
+		}
+		logger.Infof("Tracking consent %d: %s %s", reqType, tr.TrackeeName, tr.UserName)
+		switch reqType {
+		case REQUEST_TRACKING:
+			err = this.dbFor(conn).RequestTracking(tr.TrackeeName, tr.UserName)
+		case APPROVE_TRACKING:
+			err = this.dbFor(conn).ApproveTracking(tr.TrackeeName, tr.UserName)
+		case DENY_TRACKING:
+			err = this.dbFor(conn).DenyTracking(tr.TrackeeName, tr.UserName)
+		case REVOKE_TRACKING:
+			err = this.dbFor(conn).RevokeTracking(tr.TrackeeName, tr.UserName)
+		}
+		return err
+	case SET_TRACKING_PRECISION:
+		var pr PrecisionRequest
+		err = json.Unmarshal(objmap["PrecisionRequest"], &pr)
+		if err != nil {
+			return err
+		}
+		// only the trackee decides how precisely they are seen
+		if err = conn.checkUser(pr.TrackeeName); err != nil {
+			return err
+		}
+		return this.dbFor(conn).SetTrackingPrecision(pr.TrackeeName, pr.UserName, pr.Precision)
+	case GET_AUDIT_LOG:
+		var ar AuditLogRequest
+		err = json.Unmarshal(objmap["AuditLogRequest"], &ar)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(ar.UserName); err != nil {
+			return err
+		}
+		return this.GetAuditLog(ar.UserName, ar.Since, ar.Limit, requestId, conn)
+	case PAUSE_SHARING, RESUME_SHARING:
+		var pr PauseRequest
+		err = json.Unmarshal(objmap["PauseRequest"], &pr)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(pr.UserName); err != nil {
+			return err
+		}
+		switch reqType {
+		case PAUSE_SHARING:
+			err = this.dbFor(conn).PauseSharing(pr.UserName, pr.Until)
+		case RESUME_SHARING:
+			err = this.dbFor(conn).ResumeSharing(pr.UserName)
+		}
+		return err
+	case GET_WATCHERS:
+		var wr WatchersRequest
+		err = json.Unmarshal(objmap["WatchersRequest"], &wr)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(wr.UserName); err != nil {
+			return err
+		}
+		return this.GetWatchers(wr.UserName, requestId, conn)
+	case CREATE_SHARE_LINK, LIST_SHARE_LINKS, REVOKE_SHARE_LINK:
+		var sr ShareLinkRequest
+		err = json.Unmarshal(objmap["ShareLinkRequest"], &sr)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(sr.UserName); err != nil {
+			return err
+		}
+		switch reqType {
+		case CREATE_SHARE_LINK:
+			err = this.CreateShareLink(sr.UserName, sr.Duration, sr.SessionId, requestId, conn)
+		case LIST_SHARE_LINKS:
+			err = this.ListShareLinks(sr.UserName, requestId, conn)
+		case REVOKE_SHARE_LINK:
+			err = this.dbFor(conn).RevokeShareLink(sr.UserName, sr.ShareId)
+		}
+		return err
+	case CREATE_GROUP, DELETE_GROUP, LIST_GROUPS, ADD_GROUP_MEMBER, REMOVE_GROUP_MEMBER, START_GROUP_TRACKING:
+		var gr GroupRequest
+		err = json.Unmarshal(objmap["GroupRequest"], &gr)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(gr.UserName); err != nil {
+			return err
+		}
+		switch reqType {
+		case CREATE_GROUP:
+			err = this.CreateGroup(gr.UserName, gr.GroupName, requestId, conn)
+		case DELETE_GROUP:
+			err = this.dbFor(conn).DeleteGroup(gr.UserName, gr.GroupName)
+		case LIST_GROUPS:
+			err = this.ListGroups(gr.UserName, requestId, conn)
+		case ADD_GROUP_MEMBER:
+			err = this.dbFor(conn).AddGroupMember(gr.UserName, gr.GroupName, gr.TrackeeName)
+		case REMOVE_GROUP_MEMBER:
+			err = this.dbFor(conn).RemoveGroupMember(gr.UserName, gr.GroupName, gr.TrackeeName)
+		case START_GROUP_TRACKING:
+			err = this.StartGroupTracking(gr.GroupName, gr.UserName, requestId, conn)
+		}
+		return err
+	case DELETE_ACCOUNT, EXPORT_MY_DATA:
+		var ar AccountRequest
+		err = json.Unmarshal(objmap["AccountRequest"], &ar)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(ar.UserName); err != nil {
+			return err
+		}
+		switch reqType {
+		case DELETE_ACCOUNT:
+			err = this.DeleteAccount(ar.UserName, ar.Password, conn)
+		case EXPORT_MY_DATA:
+			err = this.ExportMyData(ar.UserName, requestId, conn)
+		}
+		return err
+	case CREATE_PRIVACY_ZONE, LIST_PRIVACY_ZONES, DELETE_PRIVACY_ZONE:
+		var pr PrivacyZoneRequest
+		err = json.Unmarshal(objmap["PrivacyZoneRequest"], &pr)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(pr.UserName); err != nil {
+			return err
+		}
+		switch reqType {
+		case CREATE_PRIVACY_ZONE:
+			err = this.CreatePrivacyZone(pr.UserName, pr.Zone, requestId, conn)
+		case LIST_PRIVACY_ZONES:
+			err = this.ListPrivacyZones(pr.UserName, requestId, conn)
+		case DELETE_PRIVACY_ZONE:
+			err = this.dbFor(conn).DeletePrivacyZone(pr.UserName, pr.ZoneId)
+		}
+		return err
+	case REPORT_LOCATION:
+		var rr ReportLocationRequest
+		err = json.Unmarshal(objmap["ReportLocationRequest"], &rr)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(rr.TrackeeName); err != nil {
+			return err
+		}
+		return this.ReportLocation(rr.TrackeeName, rr.Points, requestId, conn)
+	case START_SESSION, STOP_SESSION:
+		var sr SessionRequest
+		err = json.Unmarshal(objmap["SessionRequest"], &sr)
+		if err != nil {
+			return err
+		}
+		if err = conn.checkUser(sr.UserName); err != nil {
+			return err
 		}
-		break
+		switch reqType {
+		case START_SESSION:
+			err = this.StartSession(sr.UserName, requestId, conn)
+		case STOP_SESSION:
+			err = this.dbFor(conn).StopSession(sr.UserName)
+		}
+		return err
 	}
+	return nil
 }
 
-func StartService() http.HandlerFunc {
-	newService := &service{db.NewClient(), make(map[string]net.Conn)}
+func StartService(authenticator auth.Authenticator) http.HandlerFunc {
+	s := settings.NewSettings()
+	queues, err := queue.ConfigFromSettings(s)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	compression, err := newCompression(s)
+	if err != nil {
+		logger.Fatal(err)
+	}
+	newService := &service{
+		dbclient:      db.NewClient(),
+		authenticator: authenticator,
+		settings:      s,
+		queues:        queues,
+		compression:   compression,
+		subscriptions: make(map[string]*subscription),
+	}
 	handler := http.HandlerFunc(func(writer http.ResponseWriter, request *http.Request) {
-		conn, _, _, err := ws.UpgradeHTTP(request, writer)
+		if strings.HasPrefix(request.URL.Path, sharePath) {
+			newService.HandleShare(writer, request)
+			return
+		}
+		var identity *auth.Identity
+		if header := request.Header.Get("Authorization"); strings.HasPrefix(header, "Bearer ") {
+			id, err := authenticator.Authenticate(strings.TrimPrefix(header, "Bearer "))
+			if err != nil {
+				logger.Warn(err)
+				http.Error(writer, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			identity = &id
+		} else if apikey := request.Header.Get("X-Api-Key"); apikey != "" {
+			id, err := authenticator.AuthenticateApiKey(apikey)
+			if err != nil {
+				logger.Warn(err)
+				http.Error(writer, err.Error(), http.StatusUnauthorized)
+				return
+			}
+			identity = &id
+		}
+		if request.URL.Path == schemaPath {
+			newService.HandleSchema(writer, request)
+			return
+		}
+		conn, err := newService.upgrade(writer, request, identity)
 		if err != nil {
 			logger.Warn(err)
 			return
 		}
-		go func() {
-			defer conn.Close()
-
-			for {
-				msg, _, err := wsutil.ReadClientData(conn)
-				if err != nil {
-					logger.Warn(err)
-					return
-				} else {
-					logger.Infof("Msg read : %s", string(msg))
-					newService.HandleMsg(conn, msg)
-				}
-			}
-		}()
+		go newService.serve(conn, newService.HandleMsg)
 	})
 
 	return handler